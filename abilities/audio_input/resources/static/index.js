@@ -8,8 +8,19 @@ let index = {
         // Handle calibrate
         document.getElementById("btn-calibrate").addEventListener("click", index.handleCalibrate)
 
-        // Finish
-        base.finish()
+        // Get last calibration
+        asticode.tools.sendHttp({
+            method: "GET",
+            url: "../routes/calibration",
+            error: base.httpError,
+            success: function(data) {
+                // Add results
+                if (typeof data.responseJSON !== "undefined") index.addCalibrationResults(data.responseJSON)
+
+                // Finish
+                base.finish()
+            },
+        })
     },
     handleCalibrate: function() {
         // Create text
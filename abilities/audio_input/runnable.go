@@ -23,10 +23,16 @@ const (
 	samplesMessage = "audio_input.samples"
 )
 
+// Store keys
+const (
+	calibrationStoreKey = "calibration"
+)
+
 // Vars
 var (
 	calibrationDuration     = 5 * time.Second
 	calibrationStepDuration = 100 * time.Millisecond
+	silentDeviceDuration    = 30 * time.Second
 )
 
 type Stream interface {
@@ -42,22 +48,35 @@ type Stream interface {
 type Runnable struct {
 	*astibob.BaseOperatable
 	*astibob.BaseRunnable
+	c  Config
 	cs []*calibration
 	l  *Listenable
+	la time.Time   // Last time the device has read something else than silence
 	mc *sync.Mutex // Locks cs
+	mf *sync.Mutex // Locks c
+	ml *sync.Mutex // Locks la
 	s  Stream
 }
 
+// Config is the runtime config of the runnable
+type Config struct {
+	MaxSilenceAudioLevel float64 `json:"max_silence_audio_level"`
+}
+
 func NewRunnable(name string, s Stream) *Runnable {
 	// Create runnable
 	r := &Runnable{
 		BaseOperatable: newBaseOperatable(),
+		c:              Config{MaxSilenceAudioLevel: s.MaxSilenceAudioLevel()},
 		mc:             &sync.Mutex{},
+		mf:             &sync.Mutex{},
+		ml:             &sync.Mutex{},
 		s:              s,
 	}
 
 	// Add routes
 	r.AddRoute("/calibrate", http.MethodGet, r.calibrate)
+	r.AddRoute("/calibration", http.MethodGet, r.lastCalibration)
 
 	// Set listenable
 	r.l = newListenable(ListenableOptions{OnSamples: r.onSamples})
@@ -74,6 +93,68 @@ func NewRunnable(name string, s Stream) *Runnable {
 	return r
 }
 
+// Config implements the astibob.Configurable interface
+func (r *Runnable) Config() interface{} {
+	r.mf.Lock()
+	defer r.mf.Unlock()
+	return r.c
+}
+
+// ConfigSchema implements the astibob.Configurable interface
+func (r *Runnable) ConfigSchema() astibob.ConfigSchema {
+	return astibob.ConfigSchema{
+		Properties: map[string]astibob.ConfigSchema{
+			"max_silence_audio_level": {
+				Description: "Audio level under which samples are considered as silence",
+				Minimum:     astiptr.Float(0),
+				Title:       "Max silence audio level",
+				Type:        astibob.NumberConfigSchemaType,
+			},
+		},
+		Required: []string{"max_silence_audio_level"},
+		Type:     astibob.ObjectConfigSchemaType,
+	}
+}
+
+// SetConfig implements the astibob.Configurable interface
+func (r *Runnable) SetConfig(b json.RawMessage) (err error) {
+	// Unmarshal
+	var c Config
+	if err = json.Unmarshal(b, &c); err != nil {
+		err = errors.Wrap(err, "audio_input: unmarshaling failed")
+		return
+	}
+
+	// Set
+	r.mf.Lock()
+	r.c = c
+	r.mf.Unlock()
+	return
+}
+
+func (r *Runnable) maxSilenceAudioLevel() float64 {
+	r.mf.Lock()
+	defer r.mf.Unlock()
+	return r.c.MaxSilenceAudioLevel
+}
+
+// Health implements the astibob.HealthChecker interface
+func (r *Runnable) Health(ctx context.Context) astibob.Health {
+	// Get last audio
+	r.ml.Lock()
+	la := r.la
+	r.ml.Unlock()
+
+	// Device is silent
+	if d := time.Since(la); d > silentDeviceDuration {
+		return astibob.Health{
+			Message: fmt.Sprintf("device has only read silence for %s", d.Round(time.Second)),
+			Status:  astibob.DegradedHealthStatus,
+		}
+	}
+	return astibob.Health{Status: astibob.HealthyHealthStatus}
+}
+
 func (r *Runnable) MessageNames() []string {
 	return r.l.MessageNames()
 }
@@ -85,6 +166,11 @@ func (r *Runnable) onStart(ctx context.Context) (err error) {
 		return
 	}
 
+	// Reset last audio
+	r.ml.Lock()
+	r.la = time.Now()
+	r.ml.Unlock()
+
 	// Make sure to stop stream
 	defer func() {
 		if err := r.s.Stop(); err != nil {
@@ -107,6 +193,16 @@ func (r *Runnable) onStart(ctx context.Context) (err error) {
 			return
 		}
 
+		// Update last audio
+		for _, s := range b {
+			if s != 0 {
+				r.ml.Lock()
+				r.la = time.Now()
+				r.ml.Unlock()
+				break
+			}
+		}
+
 		// Create message
 		var m *astibob.Message
 		if m, err = r.newSamplesMessage(b); err != nil {
@@ -120,12 +216,17 @@ func (r *Runnable) onStart(ctx context.Context) (err error) {
 	return
 }
 
+// Samples are sent in the binary payload unless the peer only understands JSON
 type Samples struct {
 	BitDepth             int     `json:"bit_depth"`
 	MaxSilenceAudioLevel float64 `json:"max_silence_audio_level"`
 	NumChannels          int     `json:"num_channels"`
 	SampleRate           int     `json:"sample_rate"`
-	Samples              []int   `json:"samples"`
+	Samples              []int   `json:"samples,omitempty"`
+}
+
+func init() {
+	astibob.RegisterBinaryFallback(samplesMessage, samplesBinaryFallback)
 }
 
 func (r *Runnable) newSamplesMessage(b []int) (m *astibob.Message, err error) {
@@ -135,12 +236,14 @@ func (r *Runnable) newSamplesMessage(b []int) (m *astibob.Message, err error) {
 	// Set name
 	m.Name = samplesMessage
 
+	// Set binary
+	m.Binary = astibob.PCMToBytes(b)
+
 	// Marshal
 	if m.Payload, err = json.Marshal(Samples{
 		BitDepth:             r.s.BitDepth(),
-		MaxSilenceAudioLevel: r.s.MaxSilenceAudioLevel(),
+		MaxSilenceAudioLevel: r.maxSilenceAudioLevel(),
 		NumChannels:          r.s.NumChannels(),
-		Samples:              b,
 		SampleRate:           r.s.SampleRate(),
 	}); err != nil {
 		err = errors.Wrap(err, "audio_input: marshaling payload failed")
@@ -150,10 +253,32 @@ func (r *Runnable) newSamplesMessage(b []int) (m *astibob.Message, err error) {
 }
 
 func parseSamplesPayload(m *astibob.Message) (ss Samples, err error) {
+	// Unmarshal
 	if err = json.Unmarshal(m.Payload, &ss); err != nil {
 		err = errors.Wrap(err, "audio_input: unmarshaling failed")
 		return
 	}
+
+	// Binary
+	if len(m.Binary) > 0 {
+		ss.Samples = astibob.BytesToPCM(m.Binary)
+	}
+	return
+}
+
+func samplesBinaryFallback(m *astibob.Message) (err error) {
+	// Parse payload
+	var ss Samples
+	if ss, err = parseSamplesPayload(m); err != nil {
+		err = errors.Wrap(err, "audio_input: parsing samples payload failed")
+		return
+	}
+
+	// Marshal
+	if m.Payload, err = json.Marshal(ss); err != nil {
+		err = errors.Wrap(err, "audio_input: marshaling payload failed")
+		return
+	}
 	return
 }
 
@@ -203,8 +328,39 @@ func (r *Runnable) calibrate(rw http.ResponseWriter, req *http.Request, p httpro
 		return
 	}
 
+	// Get results
+	cr := c.results()
+
+	// Store results
+	if b, err := json.Marshal(cr); err != nil {
+		astilog.Error(errors.Wrap(err, "audio_input: marshaling calibration failed"))
+	} else if err = r.Store().Put(calibrationStoreKey, b); err != nil {
+		astilog.Error(errors.Wrap(err, "audio_input: storing calibration failed"))
+	}
+
 	// Write results
-	astibob.WriteHTTPData(rw, c.results())
+	astibob.WriteHTTPData(rw, cr)
+}
+
+func (r *Runnable) lastCalibration(rw http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	// Set content type
+	rw.Header().Set("Content-Type", "application/json")
+
+	// Get last calibration
+	b, ok, err := r.Store().Get(calibrationStoreKey)
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "audio_input: getting calibration failed"))
+		return
+	}
+
+	// No calibration
+	if !ok {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Write
+	rw.Write(b)
 }
 
 type calibration struct {
@@ -213,15 +369,17 @@ type calibration struct {
 	cancel context.CancelFunc
 	ctx    context.Context
 	mb     *sync.Mutex // Locks b
+	msal   float64     // Max silence audio level when the calibration has started
 	s      Stream
 }
 
 func (r *Runnable) newCalibration() (c *calibration) {
 	// Create calibration
 	c = &calibration{
-		c:  sync.NewCond(&sync.Mutex{}),
-		mb: &sync.Mutex{},
-		s:  r.s,
+		c:    sync.NewCond(&sync.Mutex{}),
+		mb:   &sync.Mutex{},
+		msal: r.maxSilenceAudioLevel(),
+		s:    r.s,
 	}
 
 	// Create context
@@ -368,7 +526,7 @@ func (c *calibration) results() (o Calibration) {
 	}
 
 	// Get current max silence audio level
-	o.CurrentMaxSilenceAudioLevel = c.s.MaxSilenceAudioLevel()
+	o.CurrentMaxSilenceAudioLevel = c.msal
 
 	// Add current max silence audio level to chart
 	o.Chart.Data.Datasets = append(o.Chart.Data.Datasets, astichartjs.Dataset{
@@ -0,0 +1,225 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Lines written by commands to their stderr are forwarded to this func
+var forwardStderr = func(name, line string) { astilog.Infof("process: %s: %s", name, line) }
+
+// process is a running command exchanging newline-delimited JSON over its stdin and stdout
+type process struct {
+	cmd    *exec.Cmd
+	mk     *sync.Mutex // Locks t
+	mw     *sync.Mutex // Locks stdin
+	name   string
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+	t      *time.Timer // Kills the command if it doesn't exit in time once stopped
+	wg     *sync.WaitGroup
+}
+
+func startProcess(name string, o Options) (p *process, err error) {
+	// Create process
+	p = &process{
+		cmd:  exec.Command(o.Path, o.Args...),
+		mk:   &sync.Mutex{},
+		mw:   &sync.Mutex{},
+		name: name,
+		wg:   &sync.WaitGroup{},
+	}
+
+	// Set dir and env
+	p.cmd.Dir = o.Dir
+	if len(o.Env) > 0 {
+		p.cmd.Env = append(os.Environ(), o.Env...)
+	}
+
+	// Get stdin
+	if p.stdin, err = p.cmd.StdinPipe(); err != nil {
+		err = errors.Wrap(err, "process: getting stdin pipe failed")
+		return
+	}
+
+	// Get stdout
+	var stdout io.ReadCloser
+	if stdout, err = p.cmd.StdoutPipe(); err != nil {
+		err = errors.Wrap(err, "process: getting stdout pipe failed")
+		return
+	}
+	p.stdout = bufio.NewReader(stdout)
+
+	// Get stderr
+	var stderr io.ReadCloser
+	if stderr, err = p.cmd.StderrPipe(); err != nil {
+		err = errors.Wrap(err, "process: getting stderr pipe failed")
+		return
+	}
+
+	// Start
+	if err = p.cmd.Start(); err != nil {
+		err = errors.Wrapf(err, "process: starting %s failed", o.Path)
+		return
+	}
+
+	// Forward stderr to logs
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		s := bufio.NewScanner(stderr)
+		for s.Scan() {
+			forwardStderr(p.name, s.Text())
+		}
+	}()
+	return
+}
+
+// The first line written by the command is its handshake
+func (p *process) handshake(ctx context.Context, timeout time.Duration) (h Handshake, err error) {
+	// Read
+	c := make(chan error, 1)
+	go func() {
+		// Read line
+		b, err := p.stdout.ReadBytes('\n')
+		if err != nil {
+			c <- errors.Wrap(err, "process: reading line failed")
+			return
+		}
+
+		// Unmarshal
+		if err = json.Unmarshal(b, &h); err != nil {
+			c <- errors.Wrapf(err, "process: unmarshaling %s failed", bytes.TrimSpace(b))
+			return
+		}
+		c <- nil
+	}()
+
+	// Wait
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case err = <-c:
+	case <-ctx.Done():
+		// Killing the command unblocks the read
+		p.kill()
+		<-c
+		err = errors.Wrap(ctx.Err(), "process: context error")
+		return
+	case <-t.C:
+		// Killing the command unblocks the read
+		p.kill()
+		<-c
+		err = fmt.Errorf("process: no handshake after %s", timeout)
+		return
+	}
+	return
+}
+
+// read reads messages until stdout is closed
+func (p *process) read(fn func(m *astibob.Message)) {
+	for {
+		// Read line
+		b, err := p.stdout.ReadBytes('\n')
+		if len(bytes.TrimSpace(b)) > 0 {
+			// Unmarshal
+			m := astibob.NewMessage()
+			if err := json.Unmarshal(b, m); err != nil {
+				astilog.Error(errors.Wrapf(err, "process: %s: unmarshaling %s failed", p.name, bytes.TrimSpace(b)))
+			} else {
+				fn(m)
+			}
+		}
+
+		// Stdout is closed
+		if err != nil {
+			if err != io.EOF {
+				astilog.Error(errors.Wrapf(err, "process: %s: reading stdout failed", p.name))
+			}
+			return
+		}
+	}
+}
+
+func (p *process) write(m *astibob.Message) (err error) {
+	// Peers only understanding JSON can't parse binary payloads
+	if m, err = m.WithoutBinary(); err != nil {
+		err = errors.Wrap(err, "process: removing binary payload failed")
+		return
+	}
+
+	// Marshal
+	var b []byte
+	if b, err = json.Marshal(m); err != nil {
+		err = errors.Wrap(err, "process: marshaling failed")
+		return
+	}
+
+	// Lock
+	p.mw.Lock()
+	defer p.mw.Unlock()
+
+	// Write
+	if _, err = p.stdin.Write(append(b, '\n')); err != nil {
+		err = errors.Wrap(err, "process: writing failed")
+		return
+	}
+	return
+}
+
+// stop closes stdin, which should make the command exit, and kills the command if it hasn't exited once the timeout
+// has been reached
+func (p *process) stop(timeout time.Duration) {
+	// Close stdin
+	p.mw.Lock()
+	p.stdin.Close()
+	p.mw.Unlock()
+
+	// Kill after timeout
+	p.mk.Lock()
+	if p.t == nil {
+		p.t = time.AfterFunc(timeout, p.kill)
+	}
+	p.mk.Unlock()
+}
+
+// Errors are ignored since the command may have exited in the meantime
+func (p *process) kill() {
+	p.cmd.Process.Kill()
+}
+
+// close stops the command, discards what's left of its stdout and waits for it to exit
+func (p *process) close(timeout time.Duration) error {
+	p.stop(timeout)
+	p.read(func(m *astibob.Message) {})
+	return p.wait()
+}
+
+// wait must only be called once stdout has been read entirely
+func (p *process) wait() (err error) {
+	// Wait for stderr to be read entirely
+	p.wg.Wait()
+
+	// Wait for the command to exit
+	err = p.cmd.Wait()
+
+	// Stop timer
+	p.mk.Lock()
+	if p.t != nil {
+		p.t.Stop()
+	}
+	p.mk.Unlock()
+	return
+}
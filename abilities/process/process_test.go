@@ -0,0 +1,197 @@
+package process
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asticode/go-astibob"
+)
+
+// Env variable making the test binary behave as a command
+const testHelperEnv = "ASTIBOB_PROCESS_HELPER"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(testHelperEnv); mode != "" {
+		runTestHelper(mode)
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// runTestHelper handshakes and, depending on the mode, echoes messages until its stdin is closed, exits right away
+// or never writes anything
+func runTestHelper(mode string) {
+	// Silent
+	if mode == "silent" {
+		time.Sleep(time.Minute)
+		return
+	}
+
+	// Handshake
+	b, _ := json.Marshal(Handshake{
+		MessageNames: []string{"ping"},
+		Metadata:     astibob.Metadata{Name: "helper"},
+	})
+	fmt.Fprintf(os.Stdout, "%s\n", b)
+	fmt.Fprintln(os.Stderr, "started")
+
+	// Exit
+	if mode == "exit" {
+		return
+	}
+
+	// Lines that are not messages are skipped
+	fmt.Fprint(os.Stdout, "\ninvalid\n")
+
+	// Echo
+	s := bufio.NewScanner(os.Stdin)
+	for s.Scan() {
+		var m astibob.Message
+		if err := json.Unmarshal(s.Bytes(), &m); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+		b, _ := json.Marshal(astibob.Message{
+			Name:    "echo",
+			Payload: m.Payload,
+		})
+		fmt.Fprintf(os.Stdout, "%s\n", b)
+	}
+	fmt.Fprintln(os.Stderr, "stopped")
+}
+
+func newTestRunnable(t *testing.T, mode string) (r *Runnable, ms chan *astibob.Message) {
+	t.Helper()
+	var err error
+	if r, err = NewRunnable(Options{
+		Env:              []string{testHelperEnv + "=" + mode},
+		HandshakeTimeout: time.Second,
+		MessageNames:     []string{"ping"},
+		Name:             "helper",
+		Path:             os.Args[0],
+		StopTimeout:      time.Second,
+	}); err != nil {
+		t.Fatalf("creating runnable failed: %v", err)
+	}
+	ms = make(chan *astibob.Message, 10)
+	r.SetDispatchFunc(func(m *astibob.Message) { ms <- m })
+	return
+}
+
+func startTestRunnable(r *Runnable) (c chan error) {
+	c = make(chan error, 1)
+	go func() { c <- r.Start(context.Background()) }()
+	return
+}
+
+func waitTestRunnable(t *testing.T, c chan error) (err error) {
+	t.Helper()
+	select {
+	case err = <-c:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected runnable to exit")
+	}
+	return
+}
+
+func TestRunnableEcho(t *testing.T) {
+	// Record stderr
+	var ls []string
+	m := &sync.Mutex{} // Locks ls
+	forwardStderr = func(name, line string) {
+		m.Lock()
+		ls = append(ls, name+": "+line)
+		m.Unlock()
+	}
+	defer func() { forwardStderr = func(name, line string) {} }()
+
+	// Start
+	r, ms := newTestRunnable(t, "echo")
+	c := startTestRunnable(r)
+
+	// Wait for the handshake
+	for d := time.Now().Add(5 * time.Second); ; {
+		r.mp.Lock()
+		p := r.p
+		r.mp.Unlock()
+		if p != nil {
+			break
+		} else if time.Now().After(d) {
+			t.Fatal("expected handshake")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s := r.Status(); s != astibob.RunningStatus {
+		t.Errorf("expected status %s, got %s", astibob.RunningStatus, s)
+	}
+
+	// Send message
+	pm := astibob.NewMessage()
+	pm.Binary = []byte("binary")
+	pm.Name = "ping"
+	pm.Payload = json.RawMessage(`"hello"`)
+	if err := r.OnMessage(pm); err != nil {
+		t.Fatalf("handling message failed: %v", err)
+	}
+
+	// Message is echoed
+	select {
+	case em := <-ms:
+		if em.Name != "echo" {
+			t.Errorf("expected name echo, got %s", em.Name)
+		}
+		if string(em.Payload) != `"hello"` {
+			t.Errorf("expected payload \"hello\", got %s", em.Payload)
+		}
+		if em.Trace == nil {
+			t.Error("expected message to be traced")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected message to be echoed")
+	}
+
+	// Stop
+	r.Stop()
+	if err := waitTestRunnable(t, c); err != astibob.ErrContextCancelled {
+		t.Errorf("expected %v, got %v", astibob.ErrContextCancelled, err)
+	}
+	if s := r.Status(); s != astibob.StoppedStatus {
+		t.Errorf("expected status %s, got %s", astibob.StoppedStatus, s)
+	}
+
+	// Nothing else has been dispatched
+	if n := len(ms); n > 0 {
+		t.Errorf("expected no other message, got %d", n)
+	}
+
+	// Stderr has been forwarded
+	m.Lock()
+	defer m.Unlock()
+	if e := []string{"helper: started", "helper: stopped"}; len(ls) != 2 || ls[0] != e[0] || ls[1] != e[1] {
+		t.Errorf("expected %v, got %v", e, ls)
+	}
+}
+
+func TestRunnableExit(t *testing.T) {
+	for _, mode := range []string{"exit", "silent"} {
+		t.Run(mode, func(t *testing.T) {
+			// Start
+			r, _ := newTestRunnable(t, mode)
+			c := startTestRunnable(r)
+
+			// Command exiting on its own or not handshaking crashes the runnable
+			if err := waitTestRunnable(t, c); err == nil || err == astibob.ErrContextCancelled {
+				t.Errorf("expected error, got %v", err)
+			}
+			if s := r.Status(); s != astibob.CrashedStatus {
+				t.Errorf("expected status %s, got %s", astibob.CrashedStatus, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Default options
+const (
+	defaultHandshakeTimeout = 5 * time.Second
+	defaultStopTimeout      = 5 * time.Second
+)
+
+// Options are the options of the command. Metadata and message names are needed before the command is started.
+type Options struct {
+	Args             []string      `toml:"args"`
+	Description      string        `toml:"description"`
+	Dir              string        `toml:"dir"`
+	Env              []string      `toml:"env"`               // Added to the environment of the worker
+	HandshakeTimeout time.Duration `toml:"handshake_timeout"` // Defaults to 5s
+	MessageNames     []string      `toml:"message_names"`     // Names of the messages the command listens to
+	Name             string        `toml:"name"`
+	Path             string        `toml:"path"`
+	StopTimeout      time.Duration `toml:"stop_timeout"` // Duration the command has to exit once its stdin is closed before being killed, defaults to 5s
+}
+
+// Handshake is the first line the command writes to its stdout. It's checked against the options.
+type Handshake struct {
+	MessageNames []string         `json:"message_names,omitempty"` // Names of the messages the command listens to
+	Metadata     astibob.Metadata `json:"metadata"`
+}
+
+// Runnable spawns a command and exchanges messages with it as newline-delimited JSON: messages sent to the runnable
+// are written to the command's stdin and messages written by the command to its stdout are dispatched. Stderr is
+// forwarded to logs.
+type Runnable struct {
+	*astibob.BaseRunnable
+	mp *sync.Mutex // Locks p
+	o  Options
+	p  *process
+}
+
+// NewRunnable creates a runnable whose metadata and message names are taken from the options. The command is only
+// run when the runnable is started and should exit once its stdin is closed.
+func NewRunnable(o Options) (r *Runnable, err error) {
+	// No name
+	if o.Name == "" {
+		err = errors.New("process: no name")
+		return
+	}
+
+	// Default options
+	if o.HandshakeTimeout <= 0 {
+		o.HandshakeTimeout = defaultHandshakeTimeout
+	}
+	if o.StopTimeout <= 0 {
+		o.StopTimeout = defaultStopTimeout
+	}
+
+	// Create runnable
+	r = &Runnable{
+		mp: &sync.Mutex{},
+		o:  o,
+	}
+
+	// Set base runnable
+	r.BaseRunnable = astibob.NewBaseRunnable(astibob.BaseRunnableOptions{
+		Metadata: astibob.Metadata{
+			Description: o.Description,
+			Name:        o.Name,
+		},
+		OnMessage: r.onMessage,
+		OnStart:   r.onStart,
+	})
+	return
+}
+
+// MessageNames implements the astibob.Listenable interface so that the runnable can be registered as a listenable
+// and receive the messages declared in the options
+func (r *Runnable) MessageNames() []string {
+	return r.o.MessageNames
+}
+
+func (r *Runnable) onStart(ctx context.Context) (err error) {
+	// Start process
+	var p *process
+	if p, err = startProcess(r.Metadata().Name, r.o); err != nil {
+		err = errors.Wrap(err, "process: starting process failed")
+		return
+	}
+
+	// Handshake
+	var h Handshake
+	if h, err = p.handshake(ctx, r.o.HandshakeTimeout); err != nil {
+		p.close(r.o.StopTimeout)
+
+		// Runnable has been stopped in the meantime
+		if ctx.Err() != nil {
+			err = nil
+			return
+		}
+		err = errors.Wrap(err, "process: handshaking failed")
+		return
+	}
+
+	// Handshake doesn't match the options
+	// Metadata and message names can't change once the runnable has been registered
+	if h.Metadata.Name != r.o.Name {
+		astilog.Warnf("process: %s: handshake name %s is ignored", r.o.Name, h.Metadata.Name)
+	}
+	if !equalMessageNames(h.MessageNames, r.o.MessageNames) {
+		astilog.Warnf("process: %s: handshake message names %v are ignored, options message names are %v", r.o.Name, h.MessageNames, r.o.MessageNames)
+	}
+
+	// Set process
+	r.mp.Lock()
+	r.p = p
+	r.mp.Unlock()
+
+	// Stop process once the context is cancelled
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			p.stop(r.o.StopTimeout)
+		case <-done:
+		}
+	}()
+
+	// Read messages until the command exits
+	p.read(r.Dispatch)
+
+	// Reset process
+	r.mp.Lock()
+	r.p = nil
+	r.mp.Unlock()
+
+	// Wait
+	err = p.wait()
+	close(done)
+
+	// Process has been stopped
+	if ctx.Err() != nil {
+		err = nil
+		return
+	}
+
+	// Process has exited on its own
+	if err != nil {
+		err = errors.Wrap(err, "process: command failed")
+	} else {
+		err = errors.New("process: command exited")
+	}
+	return
+}
+
+func equalMessageNames(a, b []string) bool {
+	// Index names
+	ns := make(map[string]bool)
+	for _, n := range a {
+		ns[n] = true
+	}
+
+	// Loop through names
+	for _, n := range b {
+		if !ns[n] {
+			return false
+		}
+		delete(ns, n)
+	}
+	return len(ns) == 0
+}
+
+func (r *Runnable) onMessage(m *astibob.Message) (err error) {
+	// Get process
+	r.mp.Lock()
+	p := r.p
+	r.mp.Unlock()
+
+	// Process is not running
+	if p == nil {
+		return
+	}
+
+	// Write
+	if err = p.write(m); err != nil {
+		err = errors.Wrap(err, "process: writing message failed")
+		return
+	}
+	return
+}
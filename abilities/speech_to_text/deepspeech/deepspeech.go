@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astibob/abilities/speech_to_text"
 	"github.com/asticode/go-astideepspeech"
 	"github.com/asticode/go-astilog"
@@ -81,6 +82,19 @@ func (d *DeepSpeech) Close() {
 	}
 }
 
+// Health implements the astibob.HealthChecker interface
+func (d *DeepSpeech) Health(ctx context.Context) astibob.Health {
+	// No model
+	if d.m == nil {
+		return astibob.Health{
+			Details: map[string]string{"model_path": d.o.ModelPath},
+			Message: "model is not loaded",
+			Status:  astibob.UnhealthyHealthStatus,
+		}
+	}
+	return astibob.Health{Status: astibob.HealthyHealthStatus}
+}
+
 func (d *DeepSpeech) Parse(samples []int, bitDepth, numChannels, sampleRate int) (t string, err error) {
 	// No model
 	if d.m == nil {
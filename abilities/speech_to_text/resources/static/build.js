@@ -38,14 +38,19 @@ let build = {
         })
     },
     messageNames: [
-        "speech_to_text.options.build.updated",
+        "runnable.config.updated",
         "speech_to_text.speech.created",
         "speech_to_text.speech.deleted",
         "speech_to_text.speech.updated",
     ],
     onMessage: function(data) {
         switch(data.name) {
-            case "speech_to_text.options.build.updated":
+            case "runnable.config.updated":
+                // Config of another runnable
+                let path = window.location.pathname.split("/")
+                if (data.from.worker !== decodeURIComponent(path[2]) || data.from.name !== decodeURIComponent(path[4])) break
+
+                // Update options
                 build.options = data.payload
                 document.querySelector("#store-new-speeches").className = "toggle " + (build.options.store_new_speeches ? "on": "off")
                 break
@@ -63,7 +68,7 @@ let build = {
     updateOptions: function() {
         asticode.tools.sendHttp({
             method: "PATCH",
-            url: "../routes/options/build",
+            url: "/api" + window.location.pathname.split("/web/")[0] + "/config",
             payload: JSON.stringify({
                 store_new_speeches: !build.options.store_new_speeches,
             }),
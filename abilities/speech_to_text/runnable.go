@@ -32,13 +32,17 @@ const (
 
 // Message names
 const (
-	optionsBuildUpdatedMessage = "speech_to_text.options.build.updated"
-	progressMessage            = "speech_to_text.progress"
-	samplesMessage             = "speech_to_text.samples"
-	speechCreatedMessage       = "speech_to_text.speech.created"
-	speechDeletedMessage       = "speech_to_text.speech.deleted"
-	speechUpdatedMessage       = "speech_to_text.speech.updated"
-	textMessage                = "speech_to_text.text"
+	progressMessage      = "speech_to_text.progress"
+	samplesMessage       = "speech_to_text.samples"
+	speechCreatedMessage = "speech_to_text.speech.created"
+	speechDeletedMessage = "speech_to_text.speech.deleted"
+	speechUpdatedMessage = "speech_to_text.speech.updated"
+	textMessage          = "speech_to_text.text"
+)
+
+// Metric names
+const (
+	parseDurationMetric = "speech_to_text_parse_duration_seconds"
 )
 
 type Parser interface {
@@ -86,41 +90,55 @@ type Runnable struct {
 	*astibob.BaseOperatable
 	*astibob.BaseRunnable
 	b      *astilimiter.Bucket
-	c      *astisync.Chan
 	cancel context.CancelFunc
+	cc     context.Context           // Context chans are started with, nil when the runnable is not running
+	cs     map[string]*astisync.Chan // Samples chans indexed by source
 	ctx    context.Context
 	i      *os.File
+	mc     *sync.Mutex // Locks cc and cs
+	mo     *sync.Mutex // Locks o
 	mp     *sync.Mutex // Locks pg and ctx
 	ms     *sync.Mutex // Locks ss
 	msd    *sync.Mutex // Locks sds
 	o      RunnableOptions
 	p      Parser
 	pg     *Progress
+	ps     chan bool // Parse slots
 	sds    map[string]*astipcm.SilenceDetector
 	ss     map[string]*Speech
+	wc     *sync.WaitGroup // Waits for chans to be done
 }
 
 type RunnableOptions struct {
+	Concurrency      int    `toml:"concurrency"` // Maximum number of sources whose samples are parsed in parallel, defaults to 1. When > 1, the parser must be safe for concurrent use.
 	SpeechesDirPath  string `toml:"speeches_dir_path"`
 	StoreNewSpeeches bool   `toml:"store_new_speeches"`
 }
 
 func NewRunnable(name string, p Parser, o RunnableOptions) *Runnable {
+	// Default options
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+
 	// Create runnable
 	r := &Runnable{
 		BaseOperatable: newBaseOperatable(),
-		c:              astisync.NewChan(astisync.ChanOptions{}),
+		cs:             make(map[string]*astisync.Chan),
+		mc:             &sync.Mutex{},
+		mo:             &sync.Mutex{},
 		mp:             &sync.Mutex{},
 		ms:             &sync.Mutex{},
 		msd:            &sync.Mutex{},
 		o:              o,
 		p:              p,
+		ps:             make(chan bool, o.Concurrency),
 		sds:            make(map[string]*astipcm.SilenceDetector),
 		ss:             make(map[string]*Speech),
+		wc:             &sync.WaitGroup{},
 	}
 
 	// Add routes
-	r.BaseOperatable.AddRoute("/options/build", http.MethodPatch, r.updateBuildOptions)
 	r.BaseOperatable.AddRoute("/references/build", http.MethodGet, r.buildReferences)
 	r.BaseOperatable.AddRoute("/references/train", http.MethodGet, r.trainReferences)
 	r.BaseOperatable.AddRoute("/speeches/*path", http.MethodGet, astibob.DirHandle(r.o.SpeechesDirPath))
@@ -135,8 +153,8 @@ func NewRunnable(name string, p Parser, o RunnableOptions) *Runnable {
 			Description: "Executes speech to text analysis when detecting silences in audio samples",
 			Name:        name,
 		},
-		OnMessage: r.onMessage,
-		OnStart:   r.onStart,
+		OnMessageWithDispatch: r.onMessage,
+		OnStart:               r.onStart,
 	})
 
 	// Create progress limiter
@@ -194,6 +212,14 @@ func (r *Runnable) Close() {
 	}
 }
 
+// Health implements the astibob.HealthChecker interface
+func (r *Runnable) Health(ctx context.Context) astibob.Health {
+	if hc, ok := r.p.(astibob.HealthChecker); ok {
+		return hc.Health(ctx)
+	}
+	return astibob.Health{Status: astibob.HealthyHealthStatus}
+}
+
 func (r *Runnable) onStart(ctx context.Context) (err error) {
 	// Reset silence detectors
 	r.msd.Lock()
@@ -202,21 +228,72 @@ func (r *Runnable) onStart(ctx context.Context) (err error) {
 	}
 	r.msd.Unlock()
 
-	// Reset chan
-	r.c.Reset()
+	// Start chans
+	r.mc.Lock()
+	r.cc = ctx
+	for _, c := range r.cs {
+		r.startChan(ctx, c)
+	}
+	r.mc.Unlock()
 
-	// Start chan
-	r.c.Start(ctx)
+	// Wait for context to be done
+	<-ctx.Done()
+
+	// Stop chans
+	r.mc.Lock()
+	r.cc = nil
+	for k, c := range r.cs {
+		c.Stop()
+		delete(r.cs, k)
+	}
+	r.mc.Unlock()
+
+	// Wait for chans to be done
+	r.wc.Wait()
+	return
+}
+
+func (r *Runnable) startChan(ctx context.Context, c *astisync.Chan) {
+	r.wc.Add(1)
+	go func() {
+		defer r.wc.Done()
+		c.Start(ctx)
+	}()
+}
+
+// Each source has its own chan so that its samples are parsed in FIFO order, while samples of different sources may
+// be parsed in parallel
+func (r *Runnable) sourceChan(from astibob.Identifier) (c *astisync.Chan) {
+	// Lock
+	r.mc.Lock()
+	defer r.mc.Unlock()
+
+	// Chan exists
+	k := silenceDetectorKey(from)
+	var ok bool
+	if c, ok = r.cs[k]; ok {
+		return
+	}
+
+	// Create chan
+	c = astisync.NewChan(astisync.ChanOptions{})
+	r.cs[k] = c
 
-	// Stop chan
-	r.c.Stop()
+	// Start chan
+	if r.cc != nil {
+		r.startChan(r.cc, c)
+	}
 	return
 }
 
-func (r *Runnable) onMessage(m *astibob.Message) (err error) {
+func silenceDetectorKey(from astibob.Identifier) string {
+	return fmt.Sprintf("worker.%s.runnable.%s", *from.Worker, *from.Name)
+}
+
+func (r *Runnable) onMessage(m *astibob.Message, dispatch astibob.DispatchFunc) (err error) {
 	switch m.Name {
 	case samplesMessage:
-		if err = r.onSamples(m); err != nil {
+		if err = r.onSamples(m, dispatch); err != nil {
 			err = errors.Wrap(err, "speech_to_text: on samples failed")
 			return
 		}
@@ -224,38 +301,65 @@ func (r *Runnable) onMessage(m *astibob.Message) (err error) {
 	return
 }
 
+// Samples are sent in the binary payload unless the peer only understands JSON
 type Samples struct {
 	BitDepth             int                `json:"bit_depth"`
 	From                 astibob.Identifier `json:"from"`
 	MaxSilenceAudioLevel float64            `json:"max_silence_audio_level"`
 	NumChannels          int                `json:"num_channels"`
 	SampleRate           int                `json:"sample_rate"`
-	Samples              []int              `json:"samples"`
+	Samples              []int              `json:"samples,omitempty"`
+}
+
+func init() {
+	astibob.RegisterBinaryFallback(samplesMessage, samplesBinaryFallback)
 }
 
 func NewSamplesMessage(from astibob.Identifier, samples []int, bitDepth, numChannels, sampleRate int, maxSilenceAudioLevel float64) worker.Message {
 	return worker.Message{
-		Name: samplesMessage,
+		Binary: astibob.PCMToBytes(samples),
+		Name:   samplesMessage,
 		Payload: Samples{
 			BitDepth:             bitDepth,
 			From:                 from,
 			MaxSilenceAudioLevel: maxSilenceAudioLevel,
 			NumChannels:          numChannels,
 			SampleRate:           sampleRate,
-			Samples:              samples,
 		},
 	}
 }
 
 func parseSamplesPayload(m *astibob.Message) (s Samples, err error) {
+	// Unmarshal
 	if err = json.Unmarshal(m.Payload, &s); err != nil {
 		err = errors.Wrap(err, "speech_to_text: unmarshaling failed")
 		return
 	}
+
+	// Binary
+	if len(m.Binary) > 0 {
+		s.Samples = astibob.BytesToPCM(m.Binary)
+	}
 	return
 }
 
-func (r *Runnable) onSamples(m *astibob.Message) (err error) {
+func samplesBinaryFallback(m *astibob.Message) (err error) {
+	// Parse payload
+	var s Samples
+	if s, err = parseSamplesPayload(m); err != nil {
+		err = errors.Wrap(err, "speech_to_text: parsing samples payload failed")
+		return
+	}
+
+	// Marshal
+	if m.Payload, err = json.Marshal(s); err != nil {
+		err = errors.Wrap(err, "speech_to_text: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func (r *Runnable) onSamples(m *astibob.Message, dispatch astibob.DispatchFunc) (err error) {
 	// Check status
 	if r.Status() != astibob.RunningStatus {
 		return
@@ -274,15 +378,16 @@ func (r *Runnable) onSamples(m *astibob.Message) (err error) {
 		return
 	}
 
-	// Make sure samples processing is non blocking but still executed in FIFO order
-	r.c.Add(r.samplesFunc(s))
+	// Make sure this is non blocking but still executed in FIFO order for each source
+	r.sourceChan(s.From).Add(r.samplesFunc(s, dispatch))
 	return
 }
 
-func (r *Runnable) samplesFunc(s Samples) func() {
+// Texts are dispatched with the dispatch func of the samples message so that they belong to its trace
+func (r *Runnable) samplesFunc(s Samples, dispatch astibob.DispatchFunc) func() {
 	return func() {
 		// Create silence detector key
-		k := fmt.Sprintf("worker.%s.runnable.%s", *s.From.Worker, *s.From.Name)
+		k := silenceDetectorKey(s.From)
 
 		// Get silence detector
 		r.msd.Lock()
@@ -310,13 +415,13 @@ func (r *Runnable) samplesFunc(s Samples) func() {
 			ss = astipcm.Normalize(ss, s.BitDepth)
 
 			// Parse speech
-			text, err := r.parseSpeech(s.From, ss, s.BitDepth, s.NumChannels, s.SampleRate)
+			text, err := r.parseSpeech(s.From, dispatch, ss, s.BitDepth, s.NumChannels, s.SampleRate)
 			if err != nil {
 				astilog.Error(errors.Wrap(err, "speech_to_text: parsing samples failed"))
 			}
 
 			// Store speech
-			if r.o.StoreNewSpeeches && r.o.SpeechesDirPath != "" {
+			if r.Config().(BuildOptions).StoreNewSpeeches && r.o.SpeechesDirPath != "" {
 				if err := r.storeSpeech(text, ss, s.BitDepth, s.NumChannels, s.SampleRate); err != nil {
 					astilog.Error(errors.Wrap(err, "speech_to_text: storing speech failed"))
 				}
@@ -356,16 +461,22 @@ func parseTextPayload(m *astibob.Message) (t Text, err error) {
 	return
 }
 
-func (r *Runnable) parseSpeech(from astibob.Identifier, ss []int, bitDepth, numChannels, sampleRate int) (text string, err error) {
+func (r *Runnable) parseSpeech(from astibob.Identifier, dispatch astibob.DispatchFunc, ss []int, bitDepth, numChannels, sampleRate int) (text string, err error) {
 	// No parser
 	if r.p == nil {
 		return
 	}
 
+	// Wait for a parse slot
+	r.ps <- true
+	defer func() { <-r.ps }()
+
 	// Parse
 	astilog.Debugf("speech_to_text: parsing %d samples from runnable %s on worker %s", len(ss), *from.Name, *from.Worker)
 	start := time.Now()
-	if text, err = r.p.Parse(ss, bitDepth, numChannels, sampleRate); err != nil {
+	text, err = r.p.Parse(ss, bitDepth, numChannels, sampleRate)
+	r.Metrics().Histogram(parseDurationMetric, "Duration of speech parses in seconds", []float64{.1, .25, .5, 1, 2.5, 5, 10, 30}, "runnable").ObserveDuration(start, r.Metadata().Name)
+	if err != nil {
 		err = errors.Wrap(err, "speech_to_text: parsing speech failed")
 		return
 	}
@@ -381,7 +492,7 @@ func (r *Runnable) parseSpeech(from astibob.Identifier, ss []int, bitDepth, numC
 		}
 
 		// Dispatch
-		r.Dispatch(m)
+		dispatch(m)
 	}
 	return
 }
@@ -546,9 +657,7 @@ func (r *Runnable) buildReferences(rw http.ResponseWriter, req *http.Request, p
 
 	// Create references
 	rf := BuildReferences{
-		Options: BuildOptions{
-			StoreNewSpeeches: r.o.StoreNewSpeeches,
-		},
+		Options:  r.Config().(BuildOptions),
 		Speeches: []Speech{},
 	}
 
@@ -559,46 +668,40 @@ func (r *Runnable) buildReferences(rw http.ResponseWriter, req *http.Request, p
 	astibob.WriteHTTPData(rw, rf)
 }
 
-func (r *Runnable) updateBuildOptions(rw http.ResponseWriter, req *http.Request, p httprouter.Params) {
-	// Set content type
-	rw.Header().Set("Content-Type", "application/json")
-
-	// Parse body
-	var b BuildOptions
-	if err := json.NewDecoder(req.Body).Decode(&b); err != nil {
-		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "speech_to_text: parsing build options payload failed"))
-		return
-	}
-
-	// Update options
-	r.o.StoreNewSpeeches = b.StoreNewSpeeches
+// Config implements the astibob.Configurable interface
+func (r *Runnable) Config() interface{} {
+	r.mo.Lock()
+	defer r.mo.Unlock()
+	return BuildOptions{StoreNewSpeeches: r.o.StoreNewSpeeches}
+}
 
-	// Create message
-	m, err := r.newBuildOptionsUpdatedMessage()
-	if err != nil {
-		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "speech_to_text: creating build options updated message failed"))
-		return
+// ConfigSchema implements the astibob.Configurable interface
+func (r *Runnable) ConfigSchema() astibob.ConfigSchema {
+	return astibob.ConfigSchema{
+		Properties: map[string]astibob.ConfigSchema{
+			"store_new_speeches": {
+				Description: "Whether new speeches are stored in order to build your dataset",
+				Title:       "Store new speeches",
+				Type:        astibob.BooleanConfigSchemaType,
+			},
+		},
+		Type: astibob.ObjectConfigSchemaType,
 	}
-
-	// Dispatch
-	r.Dispatch(m)
 }
 
-func (r *Runnable) newBuildOptionsUpdatedMessage() (m *astibob.Message, err error) {
-	// Create message
-	m = astibob.NewMessage()
-
-	// Set name
-	m.Name = optionsBuildUpdatedMessage
-
-	// Make sure the message is sent to the UI
-	m.To = &astibob.Identifier{Type: astibob.UIIdentifierType}
-
-	// Marshal
-	if m.Payload, err = json.Marshal(BuildOptions{StoreNewSpeeches: r.o.StoreNewSpeeches}); err != nil {
-		err = errors.Wrap(err, "speech_to_text: marshaling payload failed")
+// SetConfig implements the astibob.Configurable interface
+func (r *Runnable) SetConfig(b json.RawMessage) (err error) {
+	// Unmarshal
+	var o BuildOptions
+	if err = json.Unmarshal(b, &o); err != nil {
+		err = errors.Wrap(err, "speech_to_text: unmarshaling failed")
 		return
 	}
+
+	// Set
+	r.mo.Lock()
+	r.o.StoreNewSpeeches = o.StoreNewSpeeches
+	r.mo.Unlock()
 	return
 }
 
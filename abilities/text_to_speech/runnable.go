@@ -26,7 +26,28 @@ type Runnable struct {
 	s Speaker
 }
 
-func NewRunnable(name string, s Speaker) (r *Runnable) {
+// configurableRunnable is a runnable whose speaker implements the astibob.Configurable interface
+type configurableRunnable struct {
+	*Runnable
+	astibob.Configurable
+}
+
+// NewRunnable creates a new runnable. It implements the astibob.Configurable interface only if the speaker does.
+func NewRunnable(name string, s Speaker) astibob.Runnable {
+	// Create runnable
+	r := newRunnable(name, s)
+
+	// Speaker is configurable
+	if c, ok := s.(astibob.Configurable); ok {
+		return &configurableRunnable{
+			Configurable: c,
+			Runnable:     r,
+		}
+	}
+	return r
+}
+
+func newRunnable(name string, s Speaker) (r *Runnable) {
 	// Create runnable
 	r = &Runnable{
 		c: astisync.NewChan(astisync.ChanOptions{}),
@@ -57,6 +78,14 @@ func (r *Runnable) onStart(ctx context.Context) (err error) {
 	return
 }
 
+// Health implements the astibob.HealthChecker interface
+func (r *Runnable) Health(ctx context.Context) astibob.Health {
+	if hc, ok := r.s.(astibob.HealthChecker); ok {
+		return hc.Health(ctx)
+	}
+	return astibob.Health{Status: astibob.HealthyHealthStatus}
+}
+
 func (r *Runnable) onMessage(m *astibob.Message) (err error) {
 	switch m.Name {
 	case sayMessage:
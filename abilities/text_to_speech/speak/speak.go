@@ -1,16 +1,20 @@
 package speak
 
 import (
+	"encoding/json"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 
+	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
 	"github.com/go-ole/go-ole"
 	"github.com/pkg/errors"
 )
 
 type Speaker struct {
+	mo               *sync.Mutex // Locks o
 	o                Options
 	windowsIDispatch *ole.IDispatch
 	windowsIUnknown  *ole.IUnknown
@@ -21,8 +25,76 @@ type Options struct {
 	Voice         string `toml:"voice"`
 }
 
+// Config is the runtime config of the speaker
+type Config struct {
+	Voice string `json:"voice"`
+}
+
 func New(o Options) *Speaker {
-	return &Speaker{o: o}
+	return &Speaker{
+		mo: &sync.Mutex{},
+		o:  o,
+	}
+}
+
+// Config implements the astibob.Configurable interface
+func (s *Speaker) Config() interface{} {
+	s.mo.Lock()
+	defer s.mo.Unlock()
+	return Config{Voice: s.o.Voice}
+}
+
+// ConfigSchema implements the astibob.Configurable interface
+func (s *Speaker) ConfigSchema() astibob.ConfigSchema {
+	return astibob.ConfigSchema{
+		Properties: map[string]astibob.ConfigSchema{
+			"voice": {
+				Description: "Voice used to speak, the default one is used if empty",
+				Title:       "Voice",
+				Type:        astibob.StringConfigSchemaType,
+			},
+		},
+		Type: astibob.ObjectConfigSchemaType,
+	}
+}
+
+// SetConfig implements the astibob.Configurable interface
+func (s *Speaker) SetConfig(b json.RawMessage) (err error) {
+	// Unmarshal
+	var c Config
+	if err = json.Unmarshal(b, &c); err != nil {
+		err = errors.Wrap(err, "speaker: unmarshaling failed")
+		return
+	}
+
+	// Set
+	s.mo.Lock()
+	s.o.Voice = c.Voice
+	s.mo.Unlock()
+	return
+}
+
+func (s *Speaker) binaryPath(name string) string {
+	if s.o.BinaryDirPath != "" {
+		return filepath.Join(s.o.BinaryDirPath, name)
+	}
+	return name
+}
+
+func (s *Speaker) binaryHealth(name string) astibob.Health {
+	// Look for binary
+	p, err := exec.LookPath(s.binaryPath(name))
+	if err != nil {
+		return astibob.Health{
+			Details: map[string]string{"binary": s.binaryPath(name)},
+			Message: errors.Wrap(err, "speaker: looking for binary failed").Error(),
+			Status:  astibob.UnhealthyHealthStatus,
+		}
+	}
+	return astibob.Health{
+		Details: map[string]string{"binary": p},
+		Status:  astibob.HealthyHealthStatus,
+	}
 }
 
 func (s *Speaker) execute(name, i string) (err error) {
@@ -30,17 +102,15 @@ func (s *Speaker) execute(name, i string) (err error) {
 	args := []string{i}
 
 	// Add voice
-	if s.o.Voice != "" {
-		args = append([]string{"-v", s.o.Voice}, args...)
-	}
-
-	// Add binary dir path
-	if s.o.BinaryDirPath != "" {
-		name = filepath.Join(s.o.BinaryDirPath, name)
+	s.mo.Lock()
+	v := s.o.Voice
+	s.mo.Unlock()
+	if v != "" {
+		args = append([]string{"-v", v}, args...)
 	}
 
 	// Create cmd
-	cmd := exec.Command(name, args...)
+	cmd := exec.Command(s.binaryPath(name), args...)
 
 	// Execute cmd
 	astilog.Debugf("speaker: executing %s", strings.Join(cmd.Args, " "))
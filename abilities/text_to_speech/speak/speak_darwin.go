@@ -1,5 +1,11 @@
 package speak
 
+import (
+	"context"
+
+	"github.com/asticode/go-astibob"
+)
+
 func (s *Speaker) Initialize() error { return nil }
 
 func (s *Speaker) Close() error { return nil }
@@ -7,3 +13,8 @@ func (s *Speaker) Close() error { return nil }
 func (s *Speaker) Say(i string) error {
 	return s.execute("say", i)
 }
+
+// Health implements the astibob.HealthChecker interface
+func (s *Speaker) Health(ctx context.Context) astibob.Health {
+	return s.binaryHealth("say")
+}
@@ -1,6 +1,9 @@
 package speak
 
 import (
+	"context"
+
+	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
 	"github.com/go-ole/go-ole"
 	"github.com/go-ole/go-ole/oleutil"
@@ -46,6 +49,18 @@ func (s *Speaker) Close() (err error) {
 	return
 }
 
+// Health implements the astibob.HealthChecker interface
+func (s *Speaker) Health(ctx context.Context) astibob.Health {
+	// Init has not been executed
+	if s.windowsIDispatch == nil {
+		return astibob.Health{
+			Message: "ole has not been initialized",
+			Status:  astibob.UnhealthyHealthStatus,
+		}
+	}
+	return astibob.Health{Status: astibob.HealthyHealthStatus}
+}
+
 func (s *Speaker) Say(i string) (err error) {
 	// Init has not been executed
 	if s.windowsIDispatch == nil {
@@ -0,0 +1,240 @@
+// Package astibobtest runs an index and workers in the same process so that abilities can be tested end-to-end
+package astibobtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astibob/index"
+	"github.com/asticode/go-astibob/worker"
+	astiptr "github.com/asticode/go-astitools/ptr"
+	"github.com/pkg/errors"
+)
+
+// DefaultTimeout is the maximum duration assertions wait for
+var DefaultTimeout = 5 * time.Second
+
+// Harness runs an index and workers in the same process without listening on any port: HTTP requests sent by the
+// index to the workers, as well as the ones sent through the client returned by HTTPClient, are served in memory by
+// the handler of the index or of the worker matching the request host. Unless a transport is provided in the index
+// options, messages go through a memory transport shared by the index and the workers. Provided transports must not
+// rely on the HTTP servers.
+type Harness struct {
+	Index *index.Index
+	c     *http.Client
+	hs    map[string]http.Handler // Handlers indexed by host
+	id    int
+	m     *sync.Mutex // Locks hs, id and ws
+	t     astibob.Transport
+	ws    map[string]*Worker
+}
+
+// Hosts
+const indexHost = "index.astibobtest"
+
+func workerHost(idx int) string {
+	return fmt.Sprintf("worker-%d.astibobtest", idx)
+}
+
+// New creates a new harness and starts its index
+func New(o index.Options) (h *Harness, err error) {
+	// Create harness
+	h = &Harness{
+		hs: make(map[string]http.Handler),
+		m:  &sync.Mutex{},
+		ws: make(map[string]*Worker),
+	}
+	h.c = &http.Client{Transport: roundTripper{h: h}}
+
+	// Default transport
+	if o.Transport == nil {
+		o.Transport = astibob.NewMemoryTransport()
+	}
+	h.t = o.Transport
+
+	// Create index
+	o.HTTPClient = h.c
+	o.Server.Addr = indexHost
+	if h.Index, err = index.New(o); err != nil {
+		err = errors.Wrap(err, "astibobtest: creating index failed")
+		return
+	}
+
+	// Serve index
+	h.serve(indexHost, h.Index.Handler())
+	return
+}
+
+// Addr returns the address of the index
+func (h *Harness) Addr() string {
+	return "http://" + indexHost
+}
+
+// HTTPClient returns a client sending requests to the index and to the workers without going through the network
+func (h *Harness) HTTPClient() *http.Client {
+	return h.c
+}
+
+func (h *Harness) serve(host string, hd http.Handler) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	h.hs[host] = hd
+}
+
+func (h *Harness) handler(host string) (hd http.Handler, ok bool) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	hd, ok = h.hs[host]
+	return
+}
+
+// roundTripper serves requests with the handler matching their host
+type roundTripper struct {
+	h *Harness
+}
+
+func (rt roundTripper) RoundTrip(r *http.Request) (resp *http.Response, err error) {
+	// Get handler
+	hd, ok := rt.h.handler(r.URL.Host)
+	if !ok {
+		err = fmt.Errorf("astibobtest: no handler for host %s", r.URL.Host)
+		return
+	}
+
+	// Serve
+	rec := httptest.NewRecorder()
+	hd.ServeHTTP(rec, r)
+	resp = rec.Result()
+	resp.Request = r
+	return
+}
+
+// Close stops the workers and the index
+func (h *Harness) Close() {
+	// Close workers
+	h.m.Lock()
+	ws := h.ws
+	h.ws = make(map[string]*Worker)
+	h.m.Unlock()
+	for _, w := range ws {
+		w.close()
+	}
+
+	// Close index
+	h.Index.Close()
+	h.Index.Stop()
+}
+
+// Worker is a worker run by the harness
+type Worker struct {
+	*worker.Worker
+	h    *Harness
+	host string
+	name string
+}
+
+// NewWorker creates a new worker. Its index and server addresses, as well as its transport, are set by the harness.
+// Listenables should be registered before it's started.
+func (h *Harness) NewWorker(name string, o worker.Options) (w *Worker) {
+	// Create worker
+	h.m.Lock()
+	h.id++
+	w = &Worker{
+		h:    h,
+		host: workerHost(h.id),
+		name: name,
+	}
+	h.m.Unlock()
+
+	// Create underlying worker
+	o.Index.Addr = indexHost
+	o.Server.Addr = w.host
+	o.Transport = h.t
+	w.Worker = worker.New(name, o)
+	return
+}
+
+// Addr returns the address of the worker
+func (w *Worker) Addr() string {
+	return "http://" + w.host
+}
+
+// Worker returns a started worker
+func (h *Harness) Worker(name string) (w *Worker, ok bool) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	w, ok = h.ws[name]
+	return
+}
+
+// Start serves the worker, registers it to the index and waits until both the worker has been welcomed and the other
+// workers know about it
+func (w *Worker) Start(ctx context.Context) (err error) {
+	// Worker has already been started
+	w.h.m.Lock()
+	if _, ok := w.h.ws[w.name]; ok {
+		w.h.m.Unlock()
+		err = fmt.Errorf("astibobtest: worker %s has already been started", w.name)
+		return
+	}
+
+	// Listen to other workers
+	var ms []*Messages
+	for _, ow := range w.h.ws {
+		ms = append(ms, NewMessages(ow.On, astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerRegisteredMessage)}))
+	}
+	w.h.m.Unlock()
+
+	// Make sure to stop listening
+	defer func() {
+		for _, m := range ms {
+			m.Off()
+		}
+	}()
+
+	// Listen to welcome
+	wm := NewMessages(w.On, astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerWelcomeMessage)})
+	defer wm.Off()
+
+	// Serve
+	w.h.serve(w.host, w.Handler())
+
+	// Register to index
+	w.RegisterToIndex()
+
+	// Wait for welcome
+	if _, err = wm.Wait(ctx, 1); err != nil {
+		err = errors.Wrapf(err, "astibobtest: waiting for worker %s to be welcomed failed", w.name)
+		return
+	}
+
+	// Wait for other workers to know about the worker
+	for _, m := range ms {
+		if err = m.WaitFor(ctx, func(m *astibob.Message) bool {
+			mw, err := astibob.ParseWorkerRegisteredPayload(m)
+			return err == nil && mw.Name == w.name
+		}); err != nil {
+			err = errors.Wrapf(err, "astibobtest: waiting for worker %s to be registered failed", w.name)
+			return
+		}
+	}
+
+	// Add worker
+	w.h.m.Lock()
+	w.h.ws[w.name] = w
+	w.h.m.Unlock()
+	return
+}
+
+func (w *Worker) close() {
+	w.Close()
+	w.Stop()
+	w.h.m.Lock()
+	delete(w.h.hs, w.host)
+	w.h.m.Unlock()
+}
@@ -0,0 +1,340 @@
+package astibobtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astibob/index"
+	"github.com/asticode/go-astibob/worker"
+	astiptr "github.com/asticode/go-astitools/ptr"
+)
+
+const testMessage = "test.message"
+
+// testRunnable records the messages it handles and replies with their payload
+type testRunnable struct {
+	*astibob.BaseRunnable
+	f  func(m *astibob.Message) error // If set, messages are recorded only if it returns no error
+	ms *Messages
+	on astibob.MessageHandler
+}
+
+func newTestRunnable(name string) (r *testRunnable) {
+	r = &testRunnable{}
+	r.ms = NewMessages(func(c astibob.DispatchConditions, h astibob.MessageHandler) *astibob.Subscription {
+		r.on = h
+		return astibob.NewSubscription(func() {})
+	}, astibob.DispatchConditions{})
+	r.BaseRunnable = astibob.NewBaseRunnable(astibob.BaseRunnableOptions{
+		Metadata: astibob.Metadata{Name: name},
+		OnMessage: func(m *astibob.Message) error {
+			if r.f != nil {
+				if err := r.f(m); err != nil {
+					return err
+				}
+			}
+			m.Reply(json.RawMessage(m.Payload))
+			return r.on(m)
+		},
+	})
+	return
+}
+
+func (r *testRunnable) MessageNames() []string { return []string{testMessage} }
+
+func newTestMessage(t *testing.T, to *astibob.Identifier, v interface{}) (m *astibob.Message) {
+	t.Helper()
+	m = astibob.NewMessage()
+	m.Name = testMessage
+	m.To = to
+	var err error
+	if m.Payload, err = json.Marshal(v); err != nil {
+		t.Fatalf("marshaling payload failed: %v", err)
+	}
+	return
+}
+
+func newTestHarness(t *testing.T) (h *Harness, ctx context.Context) {
+	t.Helper()
+	var err error
+	if h, err = New(index.Options{}); err != nil {
+		t.Fatalf("creating harness failed: %v", err)
+	}
+	t.Cleanup(h.Close)
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(context.Background(), DefaultTimeout)
+	t.Cleanup(cancel)
+	return
+}
+
+func startTestWorker(t *testing.T, ctx context.Context, h *Harness, name string, rs ...*testRunnable) (w *Worker) {
+	t.Helper()
+	w = h.NewWorker(name, worker.Options{})
+	for _, r := range rs {
+		w.RegisterRunnables(worker.Runnable{
+			AutoStart: true,
+			Runnable:  r,
+		})
+	}
+	if err := w.Start(ctx); err != nil {
+		t.Fatalf("starting worker %s failed: %v", name, err)
+	}
+	return
+}
+
+func TestRunnableToRunnable(t *testing.T) {
+	// Start workers
+	h, ctx := newTestHarness(t)
+	r1 := newTestRunnable("Runnable #1")
+	r2 := newTestRunnable("Runnable #2")
+	startTestWorker(t, ctx, h, "Worker #1", r1)
+	startTestWorker(t, ctx, h, "Worker #2", r2)
+
+	// Dispatch
+	r1.Dispatch(newTestMessage(t, astibob.NewRunnableIdentifier("Runnable #2", "Worker #2"), "hello"))
+
+	// Assert
+	m := r2.ms.Assert(t, 1)[0]
+	if from := m.From.WorkerName(); from != "Worker #1" {
+		t.Errorf("expected message from Worker #1, got %s", from)
+	}
+	var s string
+	AssertPayload(t, m, &s)
+	if s != "hello" {
+		t.Errorf("expected hello, got %s", s)
+	}
+	if n := len(r1.ms.All()); n > 0 {
+		t.Errorf("expected no message for Runnable #1, got %d", n)
+	}
+}
+
+func TestRequestReply(t *testing.T) {
+	// Start workers
+	h, ctx := newTestHarness(t)
+	r := newTestRunnable("Runnable")
+	w1 := startTestWorker(t, ctx, h, "Worker #1")
+	startTestWorker(t, ctx, h, "Worker #2", r)
+
+	// Request
+	m, err := w1.Request(ctx, worker.MessageOptions{
+		Message: worker.Message{
+			Name:    testMessage,
+			Payload: "ping",
+		},
+		Runnable: "Runnable",
+		Worker:   "Worker #2",
+	})
+	if err != nil {
+		t.Fatalf("requesting failed: %v", err)
+	}
+
+	// Assert
+	var s string
+	AssertPayload(t, m, &s)
+	if s != "ping" {
+		t.Errorf("expected ping, got %s", s)
+	}
+	r.ms.Assert(t, 1)
+}
+
+func TestListenables(t *testing.T) {
+	// Create workers
+	h, ctx := newTestHarness(t)
+	r := newTestRunnable("Runnable")
+	w1 := startTestWorker(t, ctx, h, "Worker #1", r)
+	w2 := h.NewWorker("Worker #2", worker.Options{})
+
+	// Register listenables
+	all := newTestRunnable("All")
+	filtered := newTestRunnable("Filtered")
+	w2.RegisterListenables(worker.Listenable{
+		Listenable: all,
+		Runnable:   "Runnable",
+		Worker:     "Worker #1",
+	}, worker.Listenable{
+		Filters:    map[string]string{testMessage: `value >= 2`},
+		Listenable: filtered,
+		Runnable:   "Runnable",
+		Worker:     "Worker #1",
+	})
+
+	// Wait for the first worker to know about the listenables
+	ls := NewMessages(w1.On, astibob.DispatchConditions{Name: astiptr.Str(astibob.ListenablesRegisterMessage)})
+	defer ls.Off()
+	if err := w2.Start(ctx); err != nil {
+		t.Fatalf("starting worker failed: %v", err)
+	}
+	ls.Assert(t, 1)
+
+	// Dispatch
+	for _, v := range []int{1, 2, 3} {
+		r.Dispatch(newTestMessage(t, nil, map[string]int{"value": v}))
+	}
+
+	// Assert
+	if ms := all.ms.Assert(t, 3); len(ms) != 3 {
+		t.Errorf("expected 3 messages, got %d", len(ms))
+	}
+	ms := filtered.ms.Assert(t, 2)
+	for idx, m := range ms {
+		var p map[string]int
+		AssertPayload(t, m, &p)
+		if e := idx + 2; p["value"] != e {
+			t.Errorf("expected value %d, got %d", e, p["value"])
+		}
+	}
+}
+
+func TestAtLeastOnce(t *testing.T) {
+	// Handling fails the first time
+	h, ctx := newTestHarness(t)
+	r := newTestRunnable("Runnable")
+	var fails int
+	mf := &sync.Mutex{} // Locks fails
+	r.f = func(m *astibob.Message) error {
+		mf.Lock()
+		defer mf.Unlock()
+		if fails++; fails == 1 {
+			return errors.New("test")
+		}
+		return nil
+	}
+
+	// Start workers
+	w1 := h.NewWorker("Worker #1", worker.Options{Outbox: astibob.OutboxOptions{MinRetryPeriod: time.Millisecond}})
+	if err := w1.Start(ctx); err != nil {
+		t.Fatalf("starting worker failed: %v", err)
+	}
+	startTestWorker(t, ctx, h, "Worker #2", r)
+
+	// Listen to acks
+	as := NewMessages(w1.On, astibob.DispatchConditions{Name: astiptr.Str(astibob.MessageAckMessage)})
+	defer as.Off()
+
+	// Send
+	if err := w1.SendMessage(worker.MessageOptions{
+		Delivery: &astibob.Delivery{Mode: astibob.AtLeastOnceDeliveryMode},
+		Message: worker.Message{
+			Name:    testMessage,
+			Payload: "hello",
+		},
+		Runnable: "Runnable",
+		Worker:   "Worker #2",
+	}); err != nil {
+		t.Fatalf("sending message failed: %v", err)
+	}
+
+	// The message is acknowledged only once it has been handled successfully
+	r.ms.Assert(t, 1)
+	as.Assert(t, 1)
+	mf.Lock()
+	if fails < 2 {
+		t.Errorf("expected at least 2 attempts, got %d", fails)
+	}
+	mf.Unlock()
+
+	// Outbox is emptied
+	for len(w1.outboxEntries(t)) > 0 {
+		if ctx.Err() != nil {
+			t.Fatal("expected outbox to be empty")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Message is handled only once
+	if n := len(r.ms.All()); n != 1 {
+		t.Errorf("expected 1 message, got %d", n)
+	}
+}
+
+func (w *Worker) outboxEntries(t *testing.T) (es []astibob.OutboxEntry) {
+	t.Helper()
+	resp, err := w.h.HTTPClient().Get(w.Addr() + "/api/outbox")
+	if err != nil {
+		t.Fatalf("getting outbox failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if err = json.NewDecoder(resp.Body).Decode(&es); err != nil {
+		t.Fatalf("decoding outbox failed: %v", err)
+	}
+	return
+}
+
+func TestExpiredMessages(t *testing.T) {
+	// Start worker
+	h, ctx := newTestHarness(t)
+	r := newTestRunnable("Runnable")
+	w := startTestWorker(t, ctx, h, "Worker", r)
+
+	// Send messages as another worker
+	for _, ttl := range []time.Duration{-time.Second, time.Hour} {
+		// Create message
+		m := newTestMessage(t, astibob.NewRunnableIdentifier("Runnable", "Worker"), ttl.String())
+		m.From = *astibob.NewWorkerIdentifier("Sender")
+		m.SetTTL(ttl)
+
+		// Marshal
+		b, err := astibob.DefaultCodec().Marshal(m)
+		if err != nil {
+			t.Fatalf("marshaling failed: %v", err)
+		}
+
+		// Post
+		resp, err := h.HTTPClient().Post(w.Addr()+"/api/messages", astibob.DefaultCodec().ContentType(), bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("posting message failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// Only the message that hasn't expired is handled
+	var s string
+	AssertPayload(t, r.ms.Assert(t, 1)[0], &s)
+	if e := time.Hour.String(); s != e {
+		t.Errorf("expected %s, got %s", e, s)
+	}
+
+	// Expired message is counted
+	resp, err := h.HTTPClient().Get(w.Addr() + "/api/metrics")
+	if err != nil {
+		t.Fatalf("getting metrics failed: %v", err)
+	}
+	defer resp.Body.Close()
+	b, _ := ioutil.ReadAll(resp.Body)
+	if e := astibob.MessagesExpiredMetric + `{peer="Sender"} 1`; !strings.Contains(string(b), e) {
+		t.Errorf("expected metrics to contain %s, got %s", e, b)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	// Start worker
+	h, ctx := newTestHarness(t)
+	w := startTestWorker(t, ctx, h, "Worker")
+
+	// Loop through addresses
+	for _, u := range []string{
+		h.Addr() + "/api/ok",
+		w.Addr() + "/api/ok",
+	} {
+		// Get
+		resp, err := h.HTTPClient().Get(u)
+		if err != nil {
+			t.Fatalf("getting %s failed: %v", u, err)
+		}
+		resp.Body.Close()
+
+		// Assert
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d for %s, got %d", http.StatusOK, u, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package astibobtest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astibob/abilities/audio_input"
+	"github.com/asticode/go-astibob/abilities/speech_to_text"
+	"github.com/asticode/go-astibob/abilities/text_to_speech"
+)
+
+// ErrStreamStopped is returned by Read when the stream is stopped
+var ErrStreamStopped = errors.New("astibobtest: stream is stopped")
+
+// Compile time assertions
+var (
+	_ audio_input.Stream     = (*Stream)(nil)
+	_ speech_to_text.Parser  = (*Parser)(nil)
+	_ text_to_speech.Speaker = (*Speaker)(nil)
+)
+
+// Default silence period of fake streams
+const defaultSilencePeriod = 100 * time.Millisecond
+
+// StreamOptions are the options of a fake stream
+type StreamOptions struct {
+	BitDepth             int
+	MaxSilenceAudioLevel float64
+	NumChannels          int
+	SampleRate           int
+	SilencePeriod        time.Duration // Read returns silence when no samples have been written during this period, defaults to 100ms
+}
+
+// Stream is a fake audio_input.Stream whose Read returns the samples written with Write. Like a microphone, it returns
+// silence when nothing has been written, which gives the runnable a chance to notice it's been stopped.
+type Stream struct {
+	c  *sync.Cond // Its locker locks ss and on
+	o  StreamOptions
+	on bool
+	ss [][]int
+}
+
+// NewStream creates a new fake stream
+func NewStream(o StreamOptions) (s *Stream) {
+	// Create stream
+	s = &Stream{
+		c: sync.NewCond(&sync.Mutex{}),
+		o: o,
+	}
+
+	// Default silence period
+	if s.o.SilencePeriod <= 0 {
+		s.o.SilencePeriod = defaultSilencePeriod
+	}
+	return
+}
+
+func (s *Stream) BitDepth() int                 { return s.o.BitDepth }
+func (s *Stream) MaxSilenceAudioLevel() float64 { return s.o.MaxSilenceAudioLevel }
+func (s *Stream) NumChannels() int              { return s.o.NumChannels }
+func (s *Stream) SampleRate() int               { return s.o.SampleRate }
+
+// Write adds samples that will be returned by Read
+func (s *Stream) Write(samples []int) {
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+	s.ss = append(s.ss, samples)
+	s.c.Broadcast()
+}
+
+// Read blocks until samples have been written, the silence period has elapsed or the stream is stopped
+func (s *Stream) Read() (samples []int, err error) {
+	// Wake up once the silence period has elapsed
+	silence := false
+	t := time.AfterFunc(s.o.SilencePeriod, func() {
+		s.c.L.Lock()
+		silence = true
+		s.c.Broadcast()
+		s.c.L.Unlock()
+	})
+	defer t.Stop()
+
+	// Lock
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+
+	// Loop
+	for {
+		// Stream is stopped
+		if !s.on {
+			err = ErrStreamStopped
+			return
+		}
+
+		// Samples are available
+		if len(s.ss) > 0 {
+			samples = s.ss[0]
+			s.ss = s.ss[1:]
+			return
+		}
+
+		// Silence period has elapsed
+		if silence {
+			samples = make([]int, int(float64(s.o.SampleRate*s.o.NumChannels)*s.o.SilencePeriod.Seconds()))
+			return
+		}
+
+		// Wait
+		s.c.Wait()
+	}
+}
+
+func (s *Stream) Start() error {
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+	s.on = true
+	return nil
+}
+
+func (s *Stream) Stop() error {
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+	s.on = false
+	s.c.Broadcast()
+	return nil
+}
+
+// ParsedSamples are samples that have been handed over to a fake parser
+type ParsedSamples struct {
+	BitDepth    int
+	NumChannels int
+	SampleRate  int
+	Samples     []int
+}
+
+// Parser is a fake speech_to_text.Parser that returns the texts queued with Queue, in order, and an empty text once
+// there's none left
+type Parser struct {
+	m  *sync.Mutex // Locks ps and ts
+	ps []ParsedSamples
+	ts []string
+}
+
+// NewParser creates a new fake parser
+func NewParser() *Parser {
+	return &Parser{m: &sync.Mutex{}}
+}
+
+// Queue adds texts returned by the next calls to Parse
+func (p *Parser) Queue(texts ...string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.ts = append(p.ts, texts...)
+}
+
+func (p *Parser) Parse(samples []int, bitDepth, numChannels, sampleRate int) (text string, err error) {
+	// Lock
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	// Store samples
+	p.ps = append(p.ps, ParsedSamples{
+		BitDepth:    bitDepth,
+		NumChannels: numChannels,
+		SampleRate:  sampleRate,
+		Samples:     append([]int{}, samples...),
+	})
+
+	// Get text
+	if len(p.ts) > 0 {
+		text = p.ts[0]
+		p.ts = p.ts[1:]
+	}
+	return
+}
+
+// Parsed returns the samples that have been parsed so far
+func (p *Parser) Parsed() []ParsedSamples {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return append([]ParsedSamples{}, p.ps...)
+}
+
+// Train completes immediately
+func (p *Parser) Train(ctx context.Context, speeches []speech_to_text.SpeechFile, progressFunc func(speech_to_text.Progress)) {
+	progressFunc(speech_to_text.Progress{
+		CurrentStep: "training",
+		Progress:    100,
+		Steps:       []string{"training"},
+	})
+}
+
+// Speaker is a fake text_to_speech.Speaker that records what it's been asked to say
+type Speaker struct {
+	c  *sync.Cond // Its locker locks ss
+	ss []string
+}
+
+// NewSpeaker creates a new fake speaker
+func NewSpeaker() *Speaker {
+	return &Speaker{c: sync.NewCond(&sync.Mutex{})}
+}
+
+func (s *Speaker) Say(text string) error {
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+	s.ss = append(s.ss, text)
+	s.c.Broadcast()
+	return nil
+}
+
+// Said returns what the speaker has been asked to say so far
+func (s *Speaker) Said() []string {
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+	return append([]string{}, s.ss...)
+}
+
+// Wait waits until the speaker has been asked to say at least n things
+func (s *Speaker) Wait(ctx context.Context, n int) (ss []string, err error) {
+	// Wake up when the context is done
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		s.c.L.Lock()
+		s.c.Broadcast()
+		s.c.L.Unlock()
+	}()
+
+	// Lock
+	s.c.L.Lock()
+	defer s.c.L.Unlock()
+
+	// Loop
+	for len(s.ss) < n {
+		// Context is done
+		if ctx.Err() != nil {
+			err = ctx.Err()
+			return
+		}
+
+		// Wait
+		s.c.Wait()
+	}
+	ss = append([]string{}, s.ss...)
+	return
+}
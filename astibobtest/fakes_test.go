@@ -0,0 +1,74 @@
+package astibobtest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStream(t *testing.T) {
+	// Stopped
+	s := NewStream(StreamOptions{
+		NumChannels:   2,
+		SampleRate:    100,
+		SilencePeriod: 10 * time.Millisecond,
+	})
+	if _, err := s.Read(); err != ErrStreamStopped {
+		t.Errorf("expected %v, got %v", ErrStreamStopped, err)
+	}
+
+	// Written samples are read first
+	s.Start()
+	s.Write([]int{1, 2})
+	if ss, err := s.Read(); err != nil || !reflect.DeepEqual(ss, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v (err: %v)", ss, err)
+	}
+
+	// Silence is read when nothing has been written
+	if ss, err := s.Read(); err != nil || !reflect.DeepEqual(ss, []int{0, 0}) {
+		t.Errorf("expected [0 0], got %v (err: %v)", ss, err)
+	}
+
+	// Stop
+	s.Stop()
+	if _, err := s.Read(); err != ErrStreamStopped {
+		t.Errorf("expected %v, got %v", ErrStreamStopped, err)
+	}
+}
+
+func TestParser(t *testing.T) {
+	// Parse
+	p := NewParser()
+	p.Queue("hello")
+	for _, e := range []string{"hello", ""} {
+		if text, err := p.Parse([]int{1}, 16, 1, 16000); err != nil || text != e {
+			t.Errorf("expected %q, got %q (err: %v)", e, text, err)
+		}
+	}
+
+	// Parsed samples are recorded
+	if ps := p.Parsed(); len(ps) != 2 || ps[0].BitDepth != 16 || ps[0].SampleRate != 16000 || !reflect.DeepEqual(ps[0].Samples, []int{1}) {
+		t.Errorf("expected 2 parsed samples, got %+v", ps)
+	}
+}
+
+func TestSpeaker(t *testing.T) {
+	// Say in the background
+	s := NewSpeaker()
+	go s.Say("hello")
+
+	// Wait
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	if ss, err := s.Wait(ctx, 1); err != nil || !reflect.DeepEqual(ss, []string{"hello"}) {
+		t.Errorf("expected [hello], got %v (err: %v)", ss, err)
+	}
+
+	// Waiting stops once the context is done
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.Wait(ctx, 2); err == nil {
+		t.Error("expected error")
+	}
+}
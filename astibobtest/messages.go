@@ -0,0 +1,124 @@
+package astibobtest
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/asticode/go-astibob"
+	"github.com/pkg/errors"
+)
+
+// Messages records the messages matching conditions
+type Messages struct {
+	c  *sync.Cond // Its locker locks ms
+	ms []*astibob.Message
+	s  *astibob.Subscription
+}
+
+// NewMessages starts recording the messages matching the conditions. on is usually the On method of a worker or of
+// the index.
+func NewMessages(on astibob.OnFunc, c astibob.DispatchConditions) (ms *Messages) {
+	ms = &Messages{c: sync.NewCond(&sync.Mutex{})}
+	ms.s = on(c, ms.handle)
+	return
+}
+
+func (ms *Messages) handle(m *astibob.Message) error {
+	// Lock
+	ms.c.L.Lock()
+	defer ms.c.L.Unlock()
+
+	// Append
+	ms.ms = append(ms.ms, m)
+
+	// Signal
+	ms.c.Broadcast()
+	return nil
+}
+
+// Off stops recording
+func (ms *Messages) Off() {
+	ms.s.Off()
+}
+
+// All returns the recorded messages
+func (ms *Messages) All() []*astibob.Message {
+	ms.c.L.Lock()
+	defer ms.c.L.Unlock()
+	return append([]*astibob.Message{}, ms.ms...)
+}
+
+// Wait waits until at least n messages have been recorded and returns them
+func (ms *Messages) Wait(ctx context.Context, n int) (m []*astibob.Message, err error) {
+	err = ms.wait(ctx, func() bool {
+		if len(ms.ms) >= n {
+			m = append([]*astibob.Message{}, ms.ms...)
+			return true
+		}
+		return false
+	})
+	return
+}
+
+// WaitFor waits until a recorded message satisfies the function and returns it
+func (ms *Messages) WaitFor(ctx context.Context, fn func(m *astibob.Message) bool) (err error) {
+	return ms.wait(ctx, func() bool {
+		for _, m := range ms.ms {
+			if fn(m) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (ms *Messages) wait(ctx context.Context, fn func() bool) (err error) {
+	// Wake up when the context is done
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		ms.c.L.Lock()
+		ms.c.Broadcast()
+		ms.c.L.Unlock()
+	}()
+
+	// Lock
+	ms.c.L.Lock()
+	defer ms.c.L.Unlock()
+
+	// Loop
+	for !fn() {
+		// Context is done
+		if ctx.Err() != nil {
+			err = errors.Wrap(ctx.Err(), "astibobtest: context error")
+			return
+		}
+
+		// Wait
+		ms.c.Wait()
+	}
+	return
+}
+
+// Assert fails the test if n messages haven't been recorded within the default timeout and returns them otherwise
+func (ms *Messages) Assert(t testing.TB, n int) []*astibob.Message {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	m, err := ms.Wait(ctx, n)
+	if err != nil {
+		t.Fatalf("astibobtest: expected at least %d message(s), got %d", n, len(ms.All()))
+	}
+	return m
+}
+
+// AssertPayload fails the test if the payload of the message doesn't unmarshal into v
+func AssertPayload(t testing.TB, m *astibob.Message, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(m.Payload, v); err != nil {
+		t.Fatalf("astibobtest: unmarshaling payload of message %s failed: %v", m.Name, err)
+	}
+}
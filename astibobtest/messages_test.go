@@ -0,0 +1,48 @@
+package astibobtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/asticode/go-astibob"
+)
+
+func TestMessages(t *testing.T) {
+	// Record
+	var h astibob.MessageHandler
+	off := false
+	ms := NewMessages(func(c astibob.DispatchConditions, mh astibob.MessageHandler) *astibob.Subscription {
+		h = mh
+		return astibob.NewSubscription(func() { off = true })
+	}, astibob.DispatchConditions{})
+
+	// Handle in the background
+	go func() {
+		for _, n := range []string{"1", "2"} {
+			m := astibob.NewMessage()
+			m.Name = n
+			h(m)
+		}
+	}()
+
+	// Wait
+	if m := ms.Assert(t, 2); len(m) != 2 || m[0].Name != "1" || m[1].Name != "2" {
+		t.Errorf("expected messages 1 and 2, got %+v", m)
+	}
+	if err := ms.WaitFor(context.Background(), func(m *astibob.Message) bool { return m.Name == "2" }); err != nil {
+		t.Errorf("waiting for message 2 failed: %v", err)
+	}
+
+	// Waiting stops once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ms.Wait(ctx, 3); err == nil {
+		t.Error("expected error")
+	}
+
+	// Off
+	ms.Off()
+	if !off {
+		t.Error("expected subscription to be turned off")
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	astiptr "github.com/asticode/go-astitools/ptr"
+	"github.com/pkg/errors"
+)
+
+// Flags
+var (
+	addr       = flag.String("a", "", "the base url of the worker or index the messages are replayed into, e.g. http://127.0.0.1:4001")
+	fromName   = flag.String("fn", "", "if set, only messages sent by an identifier with this name are replayed")
+	fromType   = flag.String("ft", "", "if set, only messages sent by an identifier with this type are replayed")
+	fromWorker = flag.String("fw", "", "if set, only messages sent by an identifier with this worker are replayed")
+	input      = flag.String("i", "", "the path to the recording")
+	names      = flag.String("n", "", "if set, only messages whose name matches one of these comma separated patterns are replayed")
+	password   = flag.String("p", "", "the basic auth password")
+	speed      = flag.Float64("s", 1, "the replay speed, 2 replays messages twice as fast as they were recorded and a negative speed replays them without waiting")
+	username   = flag.String("u", "", "the basic auth username")
+)
+
+func main() {
+	// Parse flags
+	flag.Parse()
+	astilog.FlagInit()
+
+	// No address
+	if *addr == "" {
+		astilog.Fatal("main: no address provided")
+	}
+
+	// Open recording
+	f, err := os.Open(*input)
+	if err != nil {
+		astilog.Fatal(errors.Wrapf(err, "main: opening %s failed", *input))
+	}
+	defer f.Close()
+
+	// Create options
+	o := astibob.ReplayOptions{Speed: *speed}
+	if *fromName != "" || *fromType != "" || *fromWorker != "" {
+		o.From = &astibob.Identifier{Type: *fromType}
+		if *fromName != "" {
+			o.From.Name = astiptr.Str(*fromName)
+		}
+		if *fromWorker != "" {
+			o.From.Worker = astiptr.Str(*fromWorker)
+		}
+	}
+	if *names != "" {
+		o.Names = strings.Split(*names, ",")
+	}
+
+	// Handle signals
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-ch
+		cancel()
+	}()
+
+	// Replay
+	c := &http.Client{}
+	var count int
+	if err = astibob.Replay(ctx, f, o, func(m *astibob.Message) (err error) {
+		// Send
+		if err = send(ctx, c, m); err != nil {
+			err = errors.Wrap(err, "main: sending message failed")
+			return
+		}
+		count++
+		return
+	}); err != nil && ctx.Err() == nil {
+		astilog.Fatal(errors.Wrap(err, "main: replaying failed"))
+	}
+
+	// Log
+	astilog.Infof("main: %d message(s) have been replayed", count)
+}
+
+func send(ctx context.Context, c *http.Client, m *astibob.Message) (err error) {
+	// Marshal
+	var b []byte
+	if b, err = json.Marshal(m); err != nil {
+		err = errors.Wrap(err, "main: marshaling failed")
+		return
+	}
+
+	// Create request
+	u := strings.TrimRight(*addr, "/") + "/api/messages"
+	var r *http.Request
+	if r, err = http.NewRequest(http.MethodPost, u, bytes.NewReader(b)); err != nil {
+		err = errors.Wrapf(err, "main: creating POST request to %s failed", u)
+		return
+	}
+	r = r.WithContext(ctx)
+	r.Header.Set("Content-Type", astibob.DefaultCodec().ContentType())
+
+	// Add basic auth
+	if *username != "" || *password != "" {
+		r.SetBasicAuth(*username, *password)
+	}
+
+	// Send request
+	var resp *http.Response
+	if resp, err = c.Do(r); err != nil {
+		err = errors.Wrapf(err, "main: doing POST request to %s failed", u)
+		return
+	}
+	defer resp.Body.Close()
+
+	// Invalid status code
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err = errors.Errorf("main: invalid status code %d", resp.StatusCode)
+		return
+	}
+	return
+}
@@ -0,0 +1,199 @@
+package astibob
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/vmihailenco/msgpack"
+)
+
+// Codec names
+const (
+	JSONCodecName        = "json"
+	MessagePackCodecName = "msgpack"
+)
+
+// Codecs are indexed by name and listed by order of preference
+var (
+	codecs = map[string]Codec{
+		JSONCodecName:        jsonCodec{},
+		MessagePackCodecName: messagePackCodec{},
+	}
+	codecNames = []string{MessagePackCodecName, JSONCodecName}
+)
+
+// Codec encodes and decodes messages on the wire
+type Codec interface {
+	ContentType() string
+	Marshal(m *Message) ([]byte, error)
+	Name() string
+	Unmarshal(b []byte, m *Message) error
+}
+
+// CodecNames returns the names of the supported codecs by order of preference
+func CodecNames() (ns []string) {
+	ns = make([]string, len(codecNames))
+	copy(ns, codecNames)
+	return
+}
+
+// CodecByName returns the codec with the provided name
+func CodecByName(name string) (c Codec, ok bool) {
+	c, ok = codecs[name]
+	return
+}
+
+// DefaultCodec returns the codec understood by every peer
+func DefaultCodec() Codec {
+	return codecs[JSONCodecName]
+}
+
+// NegotiateCodec returns the preferred codec among the ones supported by a peer. Peers that don't advertise any codec
+// only understand JSON.
+func NegotiateCodec(names []string) Codec {
+	// Index names
+	ns := make(map[string]bool)
+	for _, n := range names {
+		ns[n] = true
+	}
+
+	// Loop through codec names
+	for _, n := range codecNames {
+		if _, ok := ns[n]; ok {
+			return codecs[n]
+		}
+	}
+	return DefaultCodec()
+}
+
+// CodecByContentType returns the codec with the provided content type. Parameters such as the charset are ignored.
+func CodecByContentType(contentType string) (c Codec, ok bool) {
+	// Remove parameters
+	if idx := strings.Index(contentType, ";"); idx >= 0 {
+		contentType = contentType[:idx]
+	}
+	contentType = strings.TrimSpace(contentType)
+
+	// Loop through codecs
+	for _, c = range codecs {
+		if c.ContentType() == contentType {
+			ok = true
+			return
+		}
+	}
+	c = nil
+	return
+}
+
+// UnmarshalMessage unmarshals a message with the codec matching the provided content type. Payloads without content
+// type have been sent by peers only understanding JSON.
+func UnmarshalMessage(contentType string, b []byte) (m *Message, err error) {
+	// Get codec
+	c := DefaultCodec()
+	if contentType != "" {
+		var ok bool
+		if c, ok = CodecByContentType(contentType); !ok {
+			err = fmt.Errorf("astibob: no codec for content type %s", contentType)
+			return
+		}
+	}
+
+	// Unmarshal
+	m = NewMessage()
+	if err = c.Unmarshal(b, m); err != nil {
+		err = errors.Wrapf(err, "astibob: unmarshaling with codec %s failed", c.Name())
+		return
+	}
+	return
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string { return "application/json" }
+
+func (jsonCodec) Marshal(m *Message) ([]byte, error) { return json.Marshal(m) }
+
+func (jsonCodec) Name() string { return JSONCodecName }
+
+func (jsonCodec) Unmarshal(b []byte, m *Message) error { return json.Unmarshal(b, m) }
+
+type messagePackCodec struct{}
+
+func (messagePackCodec) ContentType() string { return "application/msgpack" }
+
+func (messagePackCodec) Marshal(m *Message) ([]byte, error) { return msgpack.Marshal(m) }
+
+func (messagePackCodec) Name() string { return MessagePackCodecName }
+
+func (messagePackCodec) Unmarshal(b []byte, m *Message) error { return msgpack.Unmarshal(b, m) }
+
+// BinaryFallbackFunc rewrites a message carrying a binary payload so that peers only understanding JSON can parse it
+type BinaryFallbackFunc func(m *Message) error
+
+var (
+	bfs = make(map[string]BinaryFallbackFunc) // Indexed by message name
+	mbf = &sync.Mutex{}                       // Locks bfs
+)
+
+// RegisterBinaryFallback registers the func used to move the binary payload of messages with a specific name back
+// into their JSON payload
+func RegisterBinaryFallback(name string, f BinaryFallbackFunc) {
+	mbf.Lock()
+	defer mbf.Unlock()
+	bfs[name] = f
+}
+
+// WithoutBinary returns a message that peers only understanding JSON can parse
+func (m *Message) WithoutBinary() (o *Message, err error) {
+	// No binary payload
+	if len(m.Binary) == 0 {
+		o = m
+		return
+	}
+
+	// Get fallback
+	mbf.Lock()
+	f, ok := bfs[m.Name]
+	mbf.Unlock()
+
+	// No fallback
+	if !ok {
+		o = m
+		return
+	}
+
+	// Clone
+	o = m.Clone()
+
+	// Fallback
+	if err = f(o); err != nil {
+		err = errors.Wrapf(err, "astibob: binary fallback of message %s failed", m.Name)
+		return
+	}
+
+	// Remove binary payload
+	o.Binary = nil
+	return
+}
+
+// PCMToBytes packs PCM samples as 32 bits little endian integers
+func PCMToBytes(samples []int) (b []byte) {
+	b = make([]byte, 4*len(samples))
+	for idx, s := range samples {
+		binary.LittleEndian.PutUint32(b[4*idx:], uint32(int32(s)))
+	}
+	return
+}
+
+// BytesToPCM unpacks PCM samples packed with PCMToBytes
+func BytesToPCM(b []byte) (samples []int) {
+	samples = make([]int, len(b)/4)
+	for idx := range samples {
+		samples[idx] = int(int32(binary.LittleEndian.Uint32(b[4*idx:])))
+	}
+	return
+}
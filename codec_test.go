@@ -0,0 +1,144 @@
+package astibob
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func testCodecMessage() *Message {
+	m := NewMessage()
+	m.Binary = []byte{0x7b, 0x00, 0xff}
+	m.From = *NewRunnableIdentifier("runnable", "worker")
+	m.Name = "test"
+	m.Payload = json.RawMessage(`{"key":"value"}`)
+	m.To = NewWorkerIdentifier("other")
+	return m
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for _, n := range CodecNames() {
+		t.Run(n, func(t *testing.T) {
+			// Get codec
+			c, ok := CodecByName(n)
+			if !ok {
+				t.Fatalf("codec %s doesn't exist", n)
+			}
+
+			// Marshal
+			e := testCodecMessage()
+			b, err := c.Marshal(e)
+			if err != nil {
+				t.Fatalf("marshaling failed: %v", err)
+			}
+
+			// Unmarshal
+			m, err := UnmarshalMessage(c.ContentType(), b)
+			if err != nil {
+				t.Fatalf("unmarshaling failed: %v", err)
+			}
+
+			// Compare
+			if m.Name != e.Name {
+				t.Errorf("expected name %s, got %s", e.Name, m.Name)
+			}
+			if !bytes.Equal(m.Binary, e.Binary) {
+				t.Errorf("expected binary %v, got %v", e.Binary, m.Binary)
+			}
+			if !bytes.Equal(m.Payload, e.Payload) {
+				t.Errorf("expected payload %s, got %s", e.Payload, m.Payload)
+			}
+			if !reflect.DeepEqual(m.From, e.From) {
+				t.Errorf("expected from %+v, got %+v", e.From, m.From)
+			}
+			if !reflect.DeepEqual(m.To, e.To) {
+				t.Errorf("expected to %+v, got %+v", e.To, m.To)
+			}
+		})
+	}
+}
+
+func TestUnmarshalMessage(t *testing.T) {
+	// Marshal
+	j, err := DefaultCodec().Marshal(testCodecMessage())
+	if err != nil {
+		t.Fatalf("marshaling failed: %v", err)
+	}
+
+	// Payloads without content type are JSON
+	if _, err = UnmarshalMessage("", j); err != nil {
+		t.Errorf("unmarshaling without content type failed: %v", err)
+	}
+
+	// Parameters are ignored
+	if _, err = UnmarshalMessage("application/json; charset=utf-8", j); err != nil {
+		t.Errorf("unmarshaling with parameters failed: %v", err)
+	}
+
+	// Codec is not guessed from the payload
+	c, _ := CodecByName(MessagePackCodecName)
+	if _, err = UnmarshalMessage(c.ContentType(), j); err == nil {
+		t.Error("expected error when unmarshaling JSON with msgpack")
+	}
+
+	// Unknown content type
+	if _, err = UnmarshalMessage("text/plain", j); err == nil {
+		t.Error("expected error with unknown content type")
+	}
+}
+
+func TestHTTPTransportFrameTypes(t *testing.T) {
+	for _, n := range CodecNames() {
+		c, _ := CodecByName(n)
+		e := websocket.TextMessage
+		if n != JSONCodecName {
+			e = websocket.BinaryMessage
+		}
+		if mt := httpTransportMessageType(c.ContentType()); mt != e {
+			t.Errorf("expected frame type %d for codec %s, got %d", e, n, mt)
+		}
+		if ct := httpTransportContentType(httpTransportMessageType(c.ContentType())); ct != c.ContentType() {
+			t.Errorf("expected content type %s, got %s", c.ContentType(), ct)
+		}
+	}
+}
+
+func TestUnixTransportFrames(t *testing.T) {
+	// Write
+	buf := &bytes.Buffer{}
+	type frame struct {
+		contentType string
+		p           []byte
+	}
+	fs := []frame{}
+	for _, n := range CodecNames() {
+		c, _ := CodecByName(n)
+		b, err := c.Marshal(testCodecMessage())
+		if err != nil {
+			t.Fatalf("marshaling failed: %v", err)
+		}
+		fs = append(fs, frame{contentType: c.ContentType(), p: b})
+		if err = writeUnixTransportPayload(buf, c.ContentType(), b); err != nil {
+			t.Fatalf("writing failed: %v", err)
+		}
+	}
+
+	// Read
+	var rs []frame
+	readUnixTransportPayloads(buf, func(contentType string, p []byte) {
+		rs = append(rs, frame{contentType: contentType, p: p})
+	})
+	if !reflect.DeepEqual(rs, fs) {
+		t.Fatalf("expected %+v, got %+v", fs, rs)
+	}
+
+	// Unmarshal
+	for _, f := range rs {
+		if _, err := UnmarshalMessage(f.contentType, f.p); err != nil {
+			t.Errorf("unmarshaling %s failed: %v", f.contentType, err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package astibob
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Config schema types
+const (
+	ArrayConfigSchemaType   = "array"
+	BooleanConfigSchemaType = "boolean"
+	IntegerConfigSchemaType = "integer"
+	NumberConfigSchemaType  = "number"
+	ObjectConfigSchemaType  = "object"
+	StringConfigSchemaType  = "string"
+)
+
+// Configurable is an optional interface runnables can implement to be configured at runtime. Updates are validated
+// against the schema by the worker before being applied with SetConfig, and persisted if the worker is configured to.
+type Configurable interface {
+	Config() interface{}               // Current config, marshaled to a JSON object
+	ConfigSchema() ConfigSchema        // Must be of the object type
+	SetConfig(c json.RawMessage) error // Receives the whole config, not only the updated properties
+}
+
+// ConfigSchema describes a config with a subset of JSON schema: type, properties, required, items, enum, minimum and
+// maximum. Unlike JSON schema, properties that are not described are rejected.
+type ConfigSchema struct {
+	Default     interface{}             `json:"default,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Enum        []interface{}           `json:"enum,omitempty"`
+	Items       *ConfigSchema           `json:"items,omitempty"`
+	Maximum     *float64                `json:"maximum,omitempty"`
+	Minimum     *float64                `json:"minimum,omitempty"`
+	Properties  map[string]ConfigSchema `json:"properties,omitempty"`
+	Required    []string                `json:"required,omitempty"`
+	Title       string                  `json:"title,omitempty"`
+	Type        string                  `json:"type"`
+}
+
+// RunnableConfig is the config of a runnable implementing Configurable
+type RunnableConfig struct {
+	Schema ConfigSchema    `json:"schema"`
+	Values json.RawMessage `json:"values"`
+}
+
+// Validate checks the config against the schema
+func (s ConfigSchema) Validate(c json.RawMessage) (err error) {
+	// Unmarshal
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(c))
+	d.UseNumber()
+	if err = d.Decode(&v); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+
+	// Validate
+	if err = s.validate("config", v); err != nil {
+		err = errors.Wrap(err, "astibob: validating failed")
+		return
+	}
+	return
+}
+
+func (s ConfigSchema) validate(path string, v interface{}) (err error) {
+	// Switch on type
+	switch s.Type {
+	case ArrayConfigSchemaType:
+		vs, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("astibob: %s should be an array", path)
+		}
+		if s.Items != nil {
+			for idx, i := range vs {
+				if err = s.Items.validate(fmt.Sprintf("%s[%d]", path, idx), i); err != nil {
+					return
+				}
+			}
+		}
+	case BooleanConfigSchemaType:
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("astibob: %s should be a boolean", path)
+		}
+	case IntegerConfigSchemaType, NumberConfigSchemaType:
+		// Get number
+		n, ok := v.(json.Number)
+		if !ok {
+			return fmt.Errorf("astibob: %s should be a %s", path, s.Type)
+		}
+		var f float64
+		if f, err = n.Float64(); err != nil {
+			return fmt.Errorf("astibob: %s should be a %s", path, s.Type)
+		}
+
+		// Check number
+		if s.Type == IntegerConfigSchemaType && f != math.Trunc(f) {
+			return fmt.Errorf("astibob: %s should be an integer", path)
+		}
+		if s.Minimum != nil && f < *s.Minimum {
+			return fmt.Errorf("astibob: %s should be >= %v", path, *s.Minimum)
+		}
+		if s.Maximum != nil && f > *s.Maximum {
+			return fmt.Errorf("astibob: %s should be <= %v", path, *s.Maximum)
+		}
+	case ObjectConfigSchemaType:
+		// Get object
+		o, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("astibob: %s should be an object", path)
+		}
+
+		// Check required properties
+		for _, k := range s.Required {
+			if _, ok := o[k]; !ok {
+				return fmt.Errorf("astibob: %s.%s is required", path, k)
+			}
+		}
+
+		// Loop through sorted properties so that errors are consistent
+		var ks []string
+		for k := range o {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		for _, k := range ks {
+			// Property is not described
+			ps, ok := s.Properties[k]
+			if !ok {
+				return fmt.Errorf("astibob: %s.%s is unknown", path, k)
+			}
+
+			// Validate
+			if err = ps.validate(path+"."+k, o[k]); err != nil {
+				return
+			}
+		}
+	case StringConfigSchemaType:
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("astibob: %s should be a string", path)
+		}
+	default:
+		return fmt.Errorf("astibob: %s has an invalid schema type %s", path, s.Type)
+	}
+
+	// Check enum
+	if len(s.Enum) > 0 {
+		for _, e := range s.Enum {
+			if fmt.Sprint(e) == fmt.Sprint(v) {
+				return
+			}
+		}
+		return fmt.Errorf("astibob: %s should be one of %v", path, s.Enum)
+	}
+	return
+}
+
+// MergeConfig returns the config with the properties of the update, which must both be JSON objects. Properties
+// missing from the update are left untouched.
+func MergeConfig(c, update json.RawMessage) (o json.RawMessage, err error) {
+	// Unmarshal config
+	m := make(map[string]json.RawMessage)
+	if len(c) > 0 {
+		if err = json.Unmarshal(c, &m); err != nil {
+			err = errors.Wrap(err, "astibob: unmarshaling config failed")
+			return
+		}
+	}
+
+	// Unmarshal update
+	u := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(update, &u); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling update failed")
+		return
+	}
+
+	// Merge
+	for k, v := range u {
+		m[k] = v
+	}
+
+	// Marshal
+	if o, err = json.Marshal(m); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling failed")
+		return
+	}
+	return
+}
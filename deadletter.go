@@ -0,0 +1,155 @@
+package astibob
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Number of dead letters kept when no capacity is provided
+const defaultDeadLettersCapacity = 100
+
+// DeadLetter is a message whose handler returned an error or panicked
+type DeadLetter struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Error       string    `json:"error"`
+	HandlerID   int       `json:"handler_id"`
+	HandlerName string    `json:"handler_name"`
+	ID          int       `json:"id"`
+	Message     *Message  `json:"message"`
+	Panic       bool      `json:"panic"`
+	Stack       string    `json:"stack,omitempty"`
+}
+
+type deadLetters struct {
+	c  int
+	id int
+	ls []*DeadLetter
+	m  *sync.Mutex // Locks c, id and ls
+}
+
+func newDeadLetters() *deadLetters {
+	return &deadLetters{
+		c: defaultDeadLettersCapacity,
+		m: &sync.Mutex{},
+	}
+}
+
+func (ds *deadLetters) setCapacity(c int) {
+	// Lock
+	ds.m.Lock()
+	defer ds.m.Unlock()
+
+	// Default capacity
+	if c <= 0 {
+		c = defaultDeadLettersCapacity
+	}
+
+	// Update capacity
+	ds.c = c
+	ds.truncate()
+}
+
+// Assumes the mutex is held
+func (ds *deadLetters) truncate() {
+	if len(ds.ls) > ds.c {
+		ds.ls = append([]*DeadLetter{}, ds.ls[len(ds.ls)-ds.c:]...)
+	}
+}
+
+func (ds *deadLetters) add(l *DeadLetter) {
+	// Lock
+	ds.m.Lock()
+	defer ds.m.Unlock()
+
+	// Add dead letter
+	ds.id++
+	l.ID = ds.id
+	ds.ls = append(ds.ls, l)
+
+	// Oldest dead letters are removed first
+	ds.truncate()
+}
+
+func (ds *deadLetters) del(id int) (l *DeadLetter, ok bool) {
+	// Lock
+	ds.m.Lock()
+	defer ds.m.Unlock()
+
+	// Loop through dead letters
+	for idx, v := range ds.ls {
+		if v.ID == id {
+			l = v
+			ok = true
+			ds.ls = append(ds.ls[:idx], ds.ls[idx+1:]...)
+			return
+		}
+	}
+	return
+}
+
+func (ds *deadLetters) list() (ls []DeadLetter) {
+	// Lock
+	ds.m.Lock()
+	defer ds.m.Unlock()
+
+	// Loop through dead letters
+	ls = []DeadLetter{}
+	for _, l := range ds.ls {
+		ls = append(ls, *l)
+	}
+	return
+}
+
+// AddDeadLetterRoutes adds the routes listing, deleting and replaying the dead letters of the dispatcher
+func AddDeadLetterRoutes(r *httprouter.Router, d *Dispatcher) {
+	r.GET("/api/dead-letters", deadLettersHandle(d))
+	r.DELETE("/api/dead-letters/:id", delDeadLetterHandle(d))
+	r.POST("/api/dead-letters/:id/replay", replayDeadLetterHandle(d))
+}
+
+func deadLettersHandle(d *Dispatcher) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		WriteHTTPData(rw, d.DeadLetters())
+	}
+}
+
+func delDeadLetterHandle(d *Dispatcher) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Parse id
+		id, err := strconv.Atoi(p.ByName("id"))
+		if err != nil {
+			WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "astibob: parsing id failed"))
+			return
+		}
+
+		// Delete
+		if ok := d.DelDeadLetter(id); !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func replayDeadLetterHandle(d *Dispatcher) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Parse id
+		id, err := strconv.Atoi(p.ByName("id"))
+		if err != nil {
+			WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "astibob: parsing id failed"))
+			return
+		}
+
+		// Replay
+		if err = d.ReplayDeadLetter(id); err != nil {
+			WriteHTTPError(rw, http.StatusNotFound, errors.Wrap(err, "astibob: replaying dead letter failed"))
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}
+}
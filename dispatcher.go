@@ -3,21 +3,38 @@ package astibob
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/asticode/go-astilog"
-	astisync "github.com/asticode/go-astitools/sync"
 	astiworker "github.com/asticode/go-astitools/worker"
 	"github.com/pkg/errors"
 )
 
 type MessageHandler func(m *Message) error
 
+// Middleware wraps message handlers in order to add cross-cutting behaviors such as logging, metrics or message
+// rewriting
+type Middleware func(next MessageHandler) MessageHandler
+
+type dispatcherMiddleware struct {
+	c  *DispatchConditions
+	id int
+	m  Middleware
+}
+
 type dispatcherHandler struct {
-	c DispatchConditions
-	h MessageHandler
+	c  DispatchConditions
+	h  MessageHandler
+	id int
+	n  string
 }
 
+// DispatchConditions are matched against messages. Name as well as From and To names and workers can be glob patterns
+// as described in Match.
 type DispatchConditions struct {
 	From  *Identifier
 	Name  *string
@@ -36,7 +53,7 @@ func (c DispatchConditions) match(m *Message) bool {
 		if _, ok := c.Names[m.Name]; !ok {
 			return false
 		}
-	} else if c.Name != nil && *c.Name != m.Name {
+	} else if c.Name != nil && !Match(*c.Name, m.Name) {
 		return false
 	}
 
@@ -56,85 +73,434 @@ func (c DispatchConditions) match(m *Message) bool {
 }
 
 type Dispatcher struct {
+	cs  map[string]queueConcurrency // Indexed by queue key
 	ctx context.Context
-	cs  map[string]*astisync.Chan
+	dl  *deadLetters
+	es  map[string]int // Messages that expired before reaching their queue indexed by queue key
 	hs  []dispatcherHandler
-	mc  *sync.Mutex // Locks cs
-	mh  *sync.Mutex // Locks hs
+	id  int
+	mh  *sync.Mutex // Locks hs, id and ms
+	mo  *sync.Mutex // Locks cs, o, of and rc
+	mq  *sync.Mutex // Locks es and qs
+	ms  []dispatcherMiddleware
+	o   DispatcherOptions
+	of  func(o DispatcherOverflow)
+	qs  map[string]*queue
+	rc  *Recorder
 	t   astiworker.TaskFunc
 }
 
 func NewDispatcher(ctx context.Context, t astiworker.TaskFunc) *Dispatcher {
 	return &Dispatcher{
+		cs:  make(map[string]queueConcurrency),
 		ctx: ctx,
-		cs:  make(map[string]*astisync.Chan),
-		mc:  &sync.Mutex{},
+		dl:  newDeadLetters(),
+		es:  make(map[string]int),
 		mh:  &sync.Mutex{},
-		t:   t,
+		mo:  &sync.Mutex{},
+		mq:  &sync.Mutex{},
+		o: DispatcherOptions{
+			Keys:  make(map[string]QueueOptions),
+			Names: make(map[string]QueueOptions),
+		},
+		qs: make(map[string]*queue),
+		t:  t,
 	}
 }
 
 func (d *Dispatcher) Close() {
 	// Lock
-	d.mc.Lock()
-	defer d.mc.Unlock()
+	d.mq.Lock()
+	defer d.mq.Unlock()
+
+	// Stop queues
+	for _, q := range d.qs {
+		q.stop()
+	}
+}
+
+// SetOptions sets the dispatcher options. They only apply to messages dispatched afterwards.
+func (d *Dispatcher) SetOptions(o DispatcherOptions) {
+	// Lock
+	d.mo.Lock()
+	defer d.mo.Unlock()
+
+	// Set options
+	d.o.Queue = o.Queue
+	d.o.Keys = make(map[string]QueueOptions)
+	for k, v := range o.Keys {
+		d.o.Keys[k] = v
+	}
+	d.o.Names = make(map[string]QueueOptions)
+	for k, v := range o.Names {
+		d.o.Names[k] = v
+	}
+
+	// Set dead letters capacity
+	d.o.DeadLetters = o.DeadLetters
+	d.dl.setCapacity(o.DeadLetters)
+}
+
+// SetQueueOptions sets the default queue options
+func (d *Dispatcher) SetQueueOptions(o QueueOptions) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	d.o.Queue = o
+}
+
+// SetKeyQueueOptions sets the options of the queue with a specific key such as "from.runnable.<worker>.<runnable>"
+func (d *Dispatcher) SetKeyQueueOptions(key string, o QueueOptions) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	d.o.Keys[key] = o
+}
+
+// SetMessageQueueOptions sets the queue options of messages with a specific name
+func (d *Dispatcher) SetMessageQueueOptions(name string, o QueueOptions) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	d.o.Names[name] = o
+}
+
+// SetKeyConcurrency lets the queue with a specific key such as "to.runnable.<worker>.<runnable>" handle up to n
+// messages at the same time. Messages with the same ordering key are still handled in FIFO order, and if f is nil
+// messages are not ordered at all. A concurrency <= 1 restores the default behavior where messages are handled one
+// at a time.
+func (d *Dispatcher) SetKeyConcurrency(key string, n int, f OrderingKeyFunc) {
+	// Lock
+	d.mo.Lock()
+
+	// Set concurrency
+	if n > 1 {
+		d.cs[key] = queueConcurrency{
+			f: f,
+			n: n,
+		}
+	} else {
+		delete(d.cs, key)
+	}
+
+	// Unlock
+	d.mo.Unlock()
+
+	// Get queue
+	d.mq.Lock()
+	q, ok := d.qs[key]
+	d.mq.Unlock()
+
+	// Update queue
+	if ok {
+		q.setConcurrency(n)
+	}
+}
+
+func (d *Dispatcher) queueConcurrency(key string) (c queueConcurrency, ok bool) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	c, ok = d.cs[key]
+	return
+}
+
+func (d *Dispatcher) queueOptions(key, name string) (o QueueOptions, byName bool) {
+	// Lock
+	d.mo.Lock()
+	defer d.mo.Unlock()
+
+	// Message name
+	if o, byName = d.o.Names[name]; byName {
+		return
+	}
+
+	// Key
+	var ok bool
+	if o, ok = d.o.Keys[key]; ok {
+		return
+	}
+	o = d.o.Queue
+	return
+}
+
+// OnOverflow sets the callback executed when messages have been dropped because a queue was full. Overflows of a
+// queue are aggregated and reported at most once per second.
+func (d *Dispatcher) OnOverflow(f func(o DispatcherOverflow)) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	d.of = f
+}
+
+func (d *Dispatcher) overflow(o DispatcherOverflow) {
+	// Get callback
+	d.mo.Lock()
+	f := d.of
+	d.mo.Unlock()
+
+	// Callback
+	if f != nil {
+		f(o)
+	}
+}
+
+// SetRecorder sets the recorder every dispatched message is recorded to. A nil recorder stops the recording.
+func (d *Dispatcher) SetRecorder(r *Recorder) {
+	d.mo.Lock()
+	defer d.mo.Unlock()
+	d.rc = r
+}
+
+func (d *Dispatcher) record(m *Message) {
+	// Get recorder
+	d.mo.Lock()
+	r := d.rc
+	d.mo.Unlock()
+
+	// No recorder
+	if r == nil {
+		return
+	}
+
+	// Record
+	if err := r.Record(m); err != nil {
+		astilog.Error(errors.Wrap(err, "astibob: recording message failed"))
+	}
+}
+
+// Stats returns the stats of the dispatcher queues
+func (d *Dispatcher) Stats() (ss []DispatcherQueueStats) {
+	// Lock
+	d.mq.Lock()
+	defer d.mq.Unlock()
 
-	// Stop chans
-	for _, c := range d.cs {
-		c.Stop()
+	// Loop through queues
+	ss = []DispatcherQueueStats{}
+	for k, q := range d.qs {
+		s := q.stats()
+		s.Expired += d.es[k]
+		ss = append(ss, s)
 	}
+
+	// Loop through keys whose messages have all expired before reaching their queue
+	for k, n := range d.es {
+		if _, ok := d.qs[k]; !ok {
+			ss = append(ss, DispatcherQueueStats{
+				Expired: n,
+				Key:     k,
+			})
+		}
+	}
+
+	// Sort
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Key < ss[j].Key })
+	return
 }
 
+// Dispatch adds the message to the queue of each matching handler. Depending on the queue options, it may block until
+// there's room in the queue, which is why handlers should not dispatch messages to their own full queue with the block
+// policy. Expired messages are dropped, whether they're dispatched or waiting in a queue.
 func (d *Dispatcher) Dispatch(m *Message) {
+	d.dispatch(m, nil)
+}
+
+// DispatchWithCallback dispatches the message like Dispatch and calls f once all the handlers it has been dispatched
+// to have returned. f is called with the first handler error, or with an error if the message has expired or has
+// been dropped before being handled. It may be called while a queue is locked and therefore shouldn't block.
+func (d *Dispatcher) DispatchWithCallback(m *Message, f func(err error)) {
+	d.dispatch(m, f)
+}
+
+func (d *Dispatcher) dispatch(m *Message, f func(err error)) {
+	// Record
+	d.record(m)
+
+	// Message has expired
+	if m.Expired() {
+		d.expire(m)
+		if f != nil {
+			f(fmt.Errorf("astibob: message %s has expired", m.Name))
+		}
+		return
+	}
+
+	// Get handlers
+	hs := d.handlers(m)
+	if len(hs) == 0 {
+		if f != nil {
+			f(nil)
+		}
+		return
+	}
+
+	// Create callback
+	var cb *dispatchCallback
+	if f != nil {
+		cb = newDispatchCallback(len(hs), f)
+	}
+
+	// Enqueue
+	d.enqueue(m, hs, cb)
+}
+
+// dispatchCallback calls its function once all the items of a message are done
+type dispatchCallback struct {
+	err error
+	f   func(err error)
+	m   *sync.Mutex // Locks err and n
+	n   int
+}
+
+func newDispatchCallback(n int, f func(err error)) *dispatchCallback {
+	return &dispatchCallback{
+		f: f,
+		m: &sync.Mutex{},
+		n: n,
+	}
+}
+
+func (c *dispatchCallback) done(err error) {
+	// Lock
+	c.m.Lock()
+
+	// Only the first error is kept
+	if err != nil && c.err == nil {
+		c.err = err
+	}
+
+	// Update count
+	c.n--
+	n, err := c.n, c.err
+
+	// Unlock
+	c.m.Unlock()
+
+	// Callback
+	if n == 0 {
+		c.f(err)
+	}
+}
+
+func (d *Dispatcher) enqueue(m *Message, hs []dispatcherHandler, cb *dispatchCallback) {
+	// Get queue
+	q := d.queue(d.key(m))
+
+	// Get queue options
+	o, byName := d.queueOptions(q.k, m.Name)
+
+	// Get ordering key
+	var ok string
+	c, ordered := d.queueConcurrency(q.k)
+	if ordered = ordered && c.f != nil; ordered {
+		ok = c.f(m)
+	}
+
+	// Loop through handlers
+	for _, h := range hs {
+		// Create item
+		i := &queueItem{
+			c:       cb,
+			h:       h.h,
+			id:      h.id,
+			m:       m,
+			n:       h.n,
+			ok:      ok,
+			ordered: ordered,
+		}
+
+		// Create task
+		if d.t != nil {
+			i.t = d.t()
+		}
+
+		// Add to queue
+		q.add(i, o, byName)
+	}
+}
+
+// Handlers are returned wrapped in their middlewares
+func (d *Dispatcher) handlers(m *Message) (hs []dispatcherHandler) {
 	// Lock
 	d.mh.Lock()
 	defer d.mh.Unlock()
 
+	// Get middlewares
+	var ms []Middleware
+	for _, dm := range d.ms {
+		if dm.c == nil || dm.c.match(m) {
+			ms = append(ms, dm.m)
+		}
+	}
+
 	// Loop through handlers
-	var c *astisync.Chan
 	for _, h := range d.hs {
 		// No match
 		if !h.c.match(m) {
 			continue
 		}
 
-		// No chan
-		if c == nil {
-			// Get message key
-			k := d.key(m)
+		// Append
+		hs = append(hs, dispatcherHandler{
+			c:  h.c,
+			h:  chainMiddlewares(h.h, ms),
+			id: h.id,
+			n:  h.n,
+		})
+	}
 
-			// Lock
-			d.mc.Lock()
+	// Middlewares may rewrite the message
+	if len(ms) > 0 {
+		for idx := range hs {
+			hs[idx].h = cloneMessage(hs[idx].h)
+		}
+	}
+	return
+}
 
-			// Get chan
-			var ok bool
-			if c, ok = d.cs[k]; !ok {
-				// Log
-				astilog.Debugf("astibob: creating new dispatcher chan with key %s", k)
+func (d *Dispatcher) queue(k string) (q *queue) {
+	// Lock
+	d.mq.Lock()
+	defer d.mq.Unlock()
 
-				// Create chan
-				c = astisync.NewChan(astisync.ChanOptions{TaskFunc: d.t})
-				d.cs[k] = c
+	// Queue exists
+	var ok bool
+	if q, ok = d.qs[k]; ok {
+		return
+	}
 
-				// Start chan
-				go c.Start(d.ctx)
-			}
+	// Log
+	astilog.Debugf("astibob: creating new dispatcher queue with key %s", k)
 
-			// Unlock
-			d.mc.Unlock()
-		}
+	// Create queue
+	q = newQueue(d.ctx, k, d.overflow, d.deadLetter)
+	d.qs[k] = q
 
-		// Dispatch
-		d.dispatch(c, m, h.h)
+	// Set concurrency
+	if c, ok := d.queueConcurrency(k); ok {
+		q.cn = c.n
 	}
+
+	// Start queue
+	go q.start()
+	return
+}
+
+// Messages that have expired before reaching their queue don't create it
+func (d *Dispatcher) expire(m *Message) {
+	// Get key
+	k := d.key(m)
+
+	// Log
+	astilog.Debugf("astibob: dispatcher dropped expired message %s with key %s", m.Name, k)
+
+	// Update counter
+	d.mq.Lock()
+	d.es[k]++
+	d.mq.Unlock()
 }
 
-// We don't want one dispatch to delay Cmds and Events, that's why we create specific chans for each of them. For now
+// We don't want one dispatch to delay Cmds and Events, that's why we create specific queues for each of them. For now
 // we're limiting this behavior to Cmds and Events for lack of examples of other cases.
 func (d *Dispatcher) key(m *Message) string {
 	// Message to runnable: Cmds
 	if m.To != nil && m.To.Type == RunnableIdentifierType {
-		return fmt.Sprintf("to.runnable.%s.%s", *m.To.Worker, *m.To.Name)
+		return RunnableQueueKey(*m.To.Worker, *m.To.Name)
 	}
 
 	// Message from runnable: Events
@@ -144,21 +510,160 @@ func (d *Dispatcher) key(m *Message) string {
 	return "default"
 }
 
-func (d *Dispatcher) dispatch(c *astisync.Chan, m *Message, h MessageHandler) {
-	// Add to chan
-	c.Add(func() {
-		// Handle message
-		if err := h(m); err != nil {
-			astilog.Error(errors.Wrap(err, "astibob: handling message failed"))
+// RunnableQueueKey returns the key of the queue of the messages sent to a runnable
+func RunnableQueueKey(worker, runnable string) string {
+	return fmt.Sprintf("to.runnable.%s.%s", worker, runnable)
+}
+
+// On adds a handler executed when a message matches the conditions. The handler is removed when the returned
+// subscription is turned off.
+func (d *Dispatcher) On(c DispatchConditions, h MessageHandler) *Subscription {
+	return d.OnNamed(HandlerName(h), c, h)
+}
+
+// OnNamed is the same as On but the handler is identified by the provided name in dead letters, which is useful when
+// the handler wraps another one
+func (d *Dispatcher) OnNamed(name string, c DispatchConditions, h MessageHandler) *Subscription {
+	// Lock
+	d.mh.Lock()
+	defer d.mh.Unlock()
+
+	// Add handler
+	d.id++
+	id := d.id
+	d.hs = append(d.hs, dispatcherHandler{
+		c:  c,
+		h:  h,
+		id: id,
+		n:  name,
+	})
+	return NewSubscription(func() { d.off(id) })
+}
+
+func (d *Dispatcher) off(id int) {
+	// Lock
+	d.mh.Lock()
+	defer d.mh.Unlock()
+
+	// Loop through handlers
+	for idx, h := range d.hs {
+		if h.id == id {
+			d.hs = append(d.hs[:idx], d.hs[idx+1:]...)
+			return
 		}
+	}
+}
+
+// Use adds a middleware wrapping all handlers until the returned subscription is turned off. Middlewares are executed
+// in the order they've been added and can rewrite messages, since each handler of a message then gets its own copy.
+func (d *Dispatcher) Use(m Middleware) *Subscription {
+	return d.use(nil, m)
+}
+
+// UseOn adds a middleware wrapping all handlers of messages matching the conditions until the returned subscription is
+// turned off
+func (d *Dispatcher) UseOn(c DispatchConditions, m Middleware) *Subscription {
+	return d.use(&c, m)
+}
+
+func (d *Dispatcher) use(c *DispatchConditions, m Middleware) *Subscription {
+	// Lock
+	d.mh.Lock()
+	defer d.mh.Unlock()
+
+	// Add middleware
+	d.id++
+	id := d.id
+	d.ms = append(d.ms, dispatcherMiddleware{
+		c:  c,
+		id: id,
+		m:  m,
 	})
+	return NewSubscription(func() { d.unuse(id) })
 }
 
-func (d *Dispatcher) On(c DispatchConditions, h MessageHandler) {
+func (d *Dispatcher) unuse(id int) {
+	// Lock
 	d.mh.Lock()
 	defer d.mh.Unlock()
-	d.hs = append(d.hs, dispatcherHandler{
-		c: c,
-		h: h,
+
+	// Loop through middlewares
+	for idx, m := range d.ms {
+		if m.id == id {
+			d.ms = append(d.ms[:idx], d.ms[idx+1:]...)
+			return
+		}
+	}
+}
+
+func chainMiddlewares(h MessageHandler, ms []Middleware) MessageHandler {
+	for idx := len(ms) - 1; idx >= 0; idx-- {
+		h = ms[idx](h)
+	}
+	return h
+}
+
+// Middlewares may rewrite the message, which is why chains handle their own copy of it since the dispatched message
+// may be shared with other handlers or still be used by the caller
+func cloneMessage(h MessageHandler) MessageHandler {
+	return func(m *Message) error { return h(m.Clone()) }
+}
+
+// Scope creates a scope whose handlers are all removed together, at the latest when the context is done
+func (d *Dispatcher) Scope(ctx context.Context) *Scope {
+	return newScope(ctx, d.On)
+}
+
+// HandlerName returns the name of the handler's func
+func HandlerName(h MessageHandler) string {
+	if f := runtime.FuncForPC(reflect.ValueOf(h).Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
+
+func (d *Dispatcher) deadLetter(i *queueItem, err error, stack []byte) {
+	d.dl.add(&DeadLetter{
+		CreatedAt:   time.Now(),
+		Error:       err.Error(),
+		HandlerID:   i.id,
+		HandlerName: i.n,
+		Message:     i.m.Clone(),
+		Panic:       stack != nil,
+		Stack:       string(stack),
 	})
 }
+
+// DeadLetters returns the messages whose handler returned an error or panicked, oldest first
+func (d *Dispatcher) DeadLetters() []DeadLetter {
+	return d.dl.list()
+}
+
+// DelDeadLetter removes a dead letter
+func (d *Dispatcher) DelDeadLetter(id int) (ok bool) {
+	_, ok = d.dl.del(id)
+	return
+}
+
+// ReplayDeadLetter removes a dead letter and hands its message over to the handler that failed. If the handler has
+// been removed in the meantime, the message is dispatched to all matching handlers instead.
+func (d *Dispatcher) ReplayDeadLetter(id int) (err error) {
+	// Get dead letter
+	l, ok := d.dl.del(id)
+	if !ok {
+		err = fmt.Errorf("astibob: dead letter %d doesn't exist", id)
+		return
+	}
+
+	// Loop through handlers
+	for _, h := range d.handlers(l.Message) {
+		if h.id == l.HandlerID {
+			d.enqueue(l.Message, []dispatcherHandler{h}, nil)
+			return
+		}
+	}
+
+	// Dispatch
+	d.Dispatch(l.Message)
+	return
+}
@@ -0,0 +1,144 @@
+package astibob
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testDispatcher(t *testing.T) (d *Dispatcher) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	d = NewDispatcher(ctx, nil)
+	t.Cleanup(func() {
+		d.Close()
+		cancel()
+	})
+	return
+}
+
+func testDispatcherMessage(name string) *Message {
+	m := NewMessage()
+	m.Name = name
+	m.Payload = json.RawMessage(`"original"`)
+	return m
+}
+
+func TestMiddlewareRewritesCopy(t *testing.T) {
+	// Rewrite
+	d := testDispatcher(t)
+	d.Use(func(next MessageHandler) MessageHandler {
+		return func(m *Message) error {
+			m.Name = "rewritten"
+			m.Payload = json.RawMessage(`"rewritten"`)
+			return next(m)
+		}
+	})
+
+	// Handle
+	c := make(chan *Message, 1)
+	d.On(DispatchConditions{}, func(m *Message) error {
+		c <- m
+		return nil
+	})
+
+	// Dispatch
+	m := testDispatcherMessage("test")
+	d.Dispatch(m)
+
+	// Handler gets the rewritten message
+	select {
+	case hm := <-c:
+		if hm.Name != "rewritten" {
+			t.Errorf("expected name rewritten, got %s", hm.Name)
+		}
+		if hm == m {
+			t.Error("expected handler to get a copy of the message")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected message to be handled")
+	}
+
+	// Dispatched message is unchanged
+	if m.Name != "test" {
+		t.Errorf("expected name test, got %s", m.Name)
+	}
+	if string(m.Payload) != `"original"` {
+		t.Errorf("expected payload \"original\", got %s", m.Payload)
+	}
+}
+
+func TestDispatcherKeyConcurrency(t *testing.T) {
+	// Messages with the same name are ordered
+	d := testDispatcher(t)
+	d.SetKeyConcurrency("default", 2, func(m *Message) string { return m.Name })
+
+	// Handle
+	var es []string
+	m := &sync.Mutex{} // Locks es
+	bc, rc := make(chan bool), make(chan bool)
+	d.On(DispatchConditions{}, func(dm *Message) error {
+		// Store start
+		l := dm.Name + string(dm.Payload)
+		m.Lock()
+		es = append(es, l+" start")
+		m.Unlock()
+
+		// First message of a blocks until released
+		switch l {
+		case "a1":
+			<-rc
+		case "b1":
+			close(bc)
+		}
+
+		// Store end
+		m.Lock()
+		es = append(es, l+" end")
+		m.Unlock()
+		return nil
+	})
+
+	// Dispatch
+	for _, l := range []string{"a1", "a2", "b1"} {
+		dm := NewMessage()
+		dm.Name = l[:1]
+		dm.Payload = json.RawMessage(l[1:])
+		d.Dispatch(dm)
+	}
+
+	// Different keys are handled in parallel
+	select {
+	case <-bc:
+	case <-time.After(time.Second):
+		t.Fatal("expected b1 to be handled while a1 is being handled")
+	}
+
+	// Same key is handled in FIFO order
+	close(rc)
+	for dl := time.Now().Add(time.Second); ; {
+		m.Lock()
+		n := len(es)
+		m.Unlock()
+		if n == 6 {
+			break
+		} else if time.Now().After(dl) {
+			t.Fatalf("expected 6 events, got %d", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	m.Lock()
+	defer m.Unlock()
+	var as []string
+	for _, e := range es {
+		if e[0] == 'a' {
+			as = append(as, e)
+		}
+	}
+	if e := []string{"a1 start", "a1 end", "a2 start", "a2 end"}; !reflect.DeepEqual(as, e) {
+		t.Errorf("expected %v, got %v", e, as)
+	}
+}
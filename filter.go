@@ -0,0 +1,473 @@
+package astibob
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Filter operators
+const (
+	ContainsFilterOperator       = "contains"
+	EqualFilterOperator          = "=="
+	GreaterFilterOperator        = ">"
+	GreaterOrEqualFilterOperator = ">="
+	LowerFilterOperator          = "<"
+	LowerOrEqualFilterOperator   = "<="
+	MatchesFilterOperator        = "matches"
+	NotEqualFilterOperator       = "!="
+)
+
+var filterOperators = map[string]bool{
+	ContainsFilterOperator:       true,
+	EqualFilterOperator:          true,
+	GreaterFilterOperator:        true,
+	GreaterOrEqualFilterOperator: true,
+	LowerFilterOperator:          true,
+	LowerOrEqualFilterOperator:   true,
+	MatchesFilterOperator:        true,
+	NotEqualFilterOperator:       true,
+}
+
+// Filter is an expression evaluated against the JSON payload of messages such as `from.worker == "Kitchen"` or
+// `text contains "lights" && !(from.name matches "Test*")`.
+//
+// Comparisons are made of a dot-separated path to a payload field, an operator and a JSON value. Operators are ==, !=,
+// <, <=, >, >=, contains, which checks whether a string contains a substring or an array contains a value, and matches,
+// which checks whether a string matches a glob pattern as described in Match. Comparisons can be combined with &&, ||,
+// ! and parentheses. A comparison on a missing field only matches with the != operator.
+type Filter struct {
+	e string
+	n filterNode
+}
+
+type filterNode interface {
+	match(v interface{}) bool
+}
+
+// ParseFilter parses a filter expression
+func ParseFilter(expr string) (f *Filter, err error) {
+	// Tokenize
+	var ts []string
+	if ts, err = tokenizeFilter(expr); err != nil {
+		err = errors.Wrap(err, "astibob: tokenizing failed")
+		return
+	}
+
+	// Parse
+	p := &filterParser{ts: ts}
+	f = &Filter{e: expr}
+	if f.n, err = p.parseOr(); err != nil {
+		err = errors.Wrapf(err, "astibob: parsing %s failed", expr)
+		return
+	}
+
+	// Tokens are left
+	if p.idx < len(p.ts) {
+		err = fmt.Errorf("astibob: parsing %s failed: unexpected %s", expr, p.ts[p.idx])
+		return
+	}
+	return
+}
+
+// Match checks whether the payload matches the filter. Payloads that are not valid JSON never match.
+func (f *Filter) Match(payload json.RawMessage) bool {
+	// Unmarshal
+	v, err := unmarshalFilterJSON(payload)
+	if err != nil {
+		return false
+	}
+	return f.n.match(v)
+}
+
+// Numbers are kept as json.Number so that they're compared by value. The input must be a single JSON value.
+func unmarshalFilterJSON(b []byte) (v interface{}, err error) {
+	// Decode
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&v); err != nil {
+		err = errors.Wrap(err, "astibob: decoding failed")
+		return
+	}
+
+	// Data is left
+	if _, err = d.Token(); err != io.EOF {
+		err = errors.New("astibob: unexpected data after value")
+		return
+	}
+	err = nil
+	return
+}
+
+func (f *Filter) String() string { return f.e }
+
+func tokenizeFilter(expr string) (ts []string, err error) {
+	rs := []rune(expr)
+	for idx := 0; idx < len(rs); {
+		switch r := rs[idx]; {
+		case unicode.IsSpace(r):
+			idx++
+		case r == '(' || r == ')':
+			ts = append(ts, string(r))
+			idx++
+		case r == '"':
+			// Look for the closing quote
+			end := idx + 1
+			for ; end < len(rs) && rs[end] != '"'; end++ {
+				if rs[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(rs) {
+				err = fmt.Errorf("astibob: unterminated string at position %d", idx)
+				return
+			}
+			ts = append(ts, string(rs[idx:end+1]))
+			idx = end + 1
+		case strings.ContainsRune("=!<>&|", r):
+			// Two-character operators first
+			if idx+1 < len(rs) {
+				if t := string(rs[idx : idx+2]); t == "==" || t == "!=" || t == "<=" || t == ">=" || t == "&&" || t == "||" {
+					ts = append(ts, t)
+					idx += 2
+					continue
+				}
+			}
+			if r != '!' && r != '<' && r != '>' {
+				err = fmt.Errorf("astibob: unexpected %c at position %d", r, idx)
+				return
+			}
+			ts = append(ts, string(r))
+			idx++
+		default:
+			// Read word
+			// Arrays and objects are read entirely so that they can contain strings and spaces
+			end, depth := idx, 0
+			for ; end < len(rs); end++ {
+				// End of word
+				if depth <= 0 && (unicode.IsSpace(rs[end]) || strings.ContainsRune("()\"=!<>&|", rs[end])) {
+					break
+				}
+
+				// Switch on rune
+				switch rs[end] {
+				case '[', '{':
+					depth++
+				case ']', '}':
+					depth--
+				case '"':
+					// Skip string
+					for end++; end < len(rs) && rs[end] != '"'; end++ {
+						if rs[end] == '\\' {
+							end++
+						}
+					}
+				}
+			}
+			if end > len(rs) {
+				end = len(rs)
+			}
+			ts = append(ts, string(rs[idx:end]))
+			idx = end
+		}
+	}
+	return
+}
+
+type filterParser struct {
+	idx int
+	ts  []string
+}
+
+func (p *filterParser) peek() string {
+	if p.idx < len(p.ts) {
+		return p.ts[p.idx]
+	}
+	return ""
+}
+
+func (p *filterParser) next() (t string, err error) {
+	if p.idx >= len(p.ts) {
+		err = errors.New("astibob: unexpected end of expression")
+		return
+	}
+	t = p.ts[p.idx]
+	p.idx++
+	return
+}
+
+func (p *filterParser) parseOr() (n filterNode, err error) {
+	// Parse first operand
+	var ns filterOr
+	if n, err = p.parseAnd(); err != nil {
+		return
+	}
+	ns = append(ns, n)
+
+	// Parse other operands
+	for p.peek() == "||" {
+		p.idx++
+		if n, err = p.parseAnd(); err != nil {
+			return
+		}
+		ns = append(ns, n)
+	}
+
+	// Only one operand
+	if len(ns) == 1 {
+		n = ns[0]
+		return
+	}
+	n = ns
+	return
+}
+
+func (p *filterParser) parseAnd() (n filterNode, err error) {
+	// Parse first operand
+	var ns filterAnd
+	if n, err = p.parseUnary(); err != nil {
+		return
+	}
+	ns = append(ns, n)
+
+	// Parse other operands
+	for p.peek() == "&&" {
+		p.idx++
+		if n, err = p.parseUnary(); err != nil {
+			return
+		}
+		ns = append(ns, n)
+	}
+
+	// Only one operand
+	if len(ns) == 1 {
+		n = ns[0]
+		return
+	}
+	n = ns
+	return
+}
+
+func (p *filterParser) parseUnary() (n filterNode, err error) {
+	switch p.peek() {
+	case "!":
+		// Parse operand
+		p.idx++
+		if n, err = p.parseUnary(); err != nil {
+			return
+		}
+		n = filterNot{n: n}
+	case "(":
+		// Parse expression
+		p.idx++
+		if n, err = p.parseOr(); err != nil {
+			return
+		}
+
+		// Check closing parenthesis
+		var t string
+		if t, err = p.next(); err != nil {
+			return
+		} else if t != ")" {
+			err = fmt.Errorf("astibob: expected ) but got %s", t)
+			return
+		}
+	default:
+		n, err = p.parseComparison()
+	}
+	return
+}
+
+func (p *filterParser) parseComparison() (n filterNode, err error) {
+	// Get path
+	var t string
+	if t, err = p.next(); err != nil {
+		return
+	}
+	if !isFilterPath(t) {
+		err = fmt.Errorf("astibob: invalid path %s", t)
+		return
+	}
+	c := filterComparison{path: strings.Split(t, ".")}
+
+	// Get operator
+	if c.op, err = p.next(); err != nil {
+		return
+	}
+	if !filterOperators[c.op] {
+		err = fmt.Errorf("astibob: invalid operator %s", c.op)
+		return
+	}
+
+	// Get value
+	if t, err = p.next(); err != nil {
+		return
+	}
+	if c.value, err = unmarshalFilterJSON([]byte(t)); err != nil {
+		err = errors.Wrapf(err, "astibob: invalid value %s", t)
+		return
+	}
+
+	// Check value
+	switch c.op {
+	case GreaterFilterOperator, GreaterOrEqualFilterOperator, LowerFilterOperator, LowerOrEqualFilterOperator:
+		if _, ok := c.value.(json.Number); !ok {
+			err = fmt.Errorf("astibob: operator %s needs a number", c.op)
+			return
+		}
+	case MatchesFilterOperator:
+		if _, ok := c.value.(string); !ok {
+			err = fmt.Errorf("astibob: operator %s needs a string", c.op)
+			return
+		}
+	}
+	n = c
+	return
+}
+
+func isFilterPath(t string) bool {
+	if t == "" || t[0] == '"' || filterOperators[t] || t == "(" || t == ")" {
+		return false
+	}
+	for _, s := range strings.Split(t, ".") {
+		if s == "" {
+			return false
+		}
+	}
+	return true
+}
+
+type filterOr []filterNode
+
+func (ns filterOr) match(v interface{}) bool {
+	for _, n := range ns {
+		if n.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+type filterAnd []filterNode
+
+func (ns filterAnd) match(v interface{}) bool {
+	for _, n := range ns {
+		if !n.match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type filterNot struct {
+	n filterNode
+}
+
+func (n filterNot) match(v interface{}) bool { return !n.n.match(v) }
+
+type filterComparison struct {
+	op    string
+	path  []string
+	value interface{}
+}
+
+func (c filterComparison) match(v interface{}) bool {
+	// Get field
+	f, ok := filterField(v, c.path)
+	if !ok {
+		return c.op == NotEqualFilterOperator
+	}
+
+	// Switch on operator
+	switch c.op {
+	case ContainsFilterOperator:
+		switch f := f.(type) {
+		case string:
+			s, ok := c.value.(string)
+			return ok && strings.Contains(f, s)
+		case []interface{}:
+			for _, i := range f {
+				if filterEqual(i, c.value) {
+					return true
+				}
+			}
+		}
+		return false
+	case EqualFilterOperator:
+		return filterEqual(f, c.value)
+	case MatchesFilterOperator:
+		s, ok := f.(string)
+		return ok && Match(c.value.(string), s)
+	case NotEqualFilterOperator:
+		return !filterEqual(f, c.value)
+	default:
+		// Get numbers
+		a, ok := filterNumber(f)
+		if !ok {
+			return false
+		}
+		b, _ := filterNumber(c.value)
+
+		// Compare
+		switch c.op {
+		case GreaterFilterOperator:
+			return a > b
+		case GreaterOrEqualFilterOperator:
+			return a >= b
+		case LowerFilterOperator:
+			return a < b
+		default:
+			return a <= b
+		}
+	}
+}
+
+// Path segments are either object keys or array indexes
+func filterField(v interface{}, path []string) (interface{}, bool) {
+	for _, s := range path {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = t[s]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			idx, err := strconv.Atoi(s)
+			if err != nil || idx < 0 || idx >= len(t) {
+				return nil, false
+			}
+			v = t[idx]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func filterNumber(v interface{}) (f float64, ok bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return
+	}
+	var err error
+	if f, err = n.Float64(); err != nil {
+		return 0, false
+	}
+	return
+}
+
+// Numbers are compared by value so that 1 equals 1.0
+func filterEqual(a, b interface{}) bool {
+	if fa, ok := filterNumber(a); ok {
+		fb, ok := filterNumber(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
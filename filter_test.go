@@ -0,0 +1,132 @@
+package astibob
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	for _, c := range []struct {
+		expr  string
+		valid bool
+	}{
+		// Valid
+		{expr: `a == 1`, valid: true},
+		{expr: `a.b.0 != "x"`, valid: true},
+		{expr: `a >= 1.5 && b < -2`, valid: true},
+		{expr: `a == true || a == null`, valid: true},
+		{expr: `!(a contains "x") && b matches "Test*"`, valid: true},
+		{expr: `((a == 1))`, valid: true},
+		{expr: `a == "with \"quotes\""`, valid: true},
+		{expr: `a == [1,2]`, valid: true},
+		{expr: `a == ["x y",{"b":"]"}]`, valid: true},
+
+		// Malformed
+		{expr: ``},
+		{expr: `a`},
+		{expr: `a ==`},
+		{expr: `== 1`},
+		{expr: `a = 1`},
+		{expr: `a === 1`},
+		{expr: `a == 1abc`},
+		{expr: `a == 1 2`},
+		{expr: `a == abc`},
+		{expr: `a == "x`},
+		{expr: `a.. == 1`},
+		{expr: `"a" == 1`},
+		{expr: `a unknown 1`},
+		{expr: `(a == 1`},
+		{expr: `a == 1)`},
+		{expr: `a == 1 &&`},
+		{expr: `a == 1 & b == 2`},
+		{expr: `a == [1,2`},
+		{expr: `a == ["x"`},
+		{expr: `a == ["x"]]`},
+
+		// Type mismatches
+		{expr: `a > "1"`},
+		{expr: `a <= true`},
+		{expr: `a matches 1`},
+	} {
+		f, err := ParseFilter(c.expr)
+		if c.valid && err != nil {
+			t.Errorf("expected %s to be valid, got %v", c.expr, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("expected %s to be invalid", c.expr)
+		} else if c.valid && f.String() != c.expr {
+			t.Errorf("expected expression %s, got %s", c.expr, f.String())
+		}
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	p := json.RawMessage(`{"from":{"name":"Test #1","worker":"Kitchen"},"n":2,"f":1.5,"b":true,"z":null,"text":"turn on the lights","tags":["a",1],"list":[{"v":3}]}`)
+	for _, c := range []struct {
+		expr    string
+		match   bool
+		payload json.RawMessage
+	}{
+		// Comparisons
+		{expr: `from.worker == "Kitchen"`, match: true},
+		{expr: `from.worker != "Kitchen"`},
+		{expr: `from.name matches "Test*"`, match: true},
+		{expr: `from.name matches "Other*"`},
+		{expr: `text contains "lights"`, match: true},
+		{expr: `text contains "door"`},
+		{expr: `tags contains "a"`, match: true},
+		{expr: `tags contains 1.0`, match: true},
+		{expr: `tags contains "b"`},
+		{expr: `n == 2.0`, match: true},
+		{expr: `n > 1`, match: true},
+		{expr: `n >= 2`, match: true},
+		{expr: `n < 2`},
+		{expr: `f <= 1.5`, match: true},
+		{expr: `b == true`, match: true},
+		{expr: `z == null`, match: true},
+		{expr: `list.0.v == 3`, match: true},
+		{expr: `tags == ["a",1]`, match: true},
+
+		// Combinations
+		{expr: `n == 2 && b == true`, match: true},
+		{expr: `n == 1 && b == true`},
+		{expr: `n == 1 || b == true`, match: true},
+		{expr: `!(n == 1) && (b == false || f == 1.5)`, match: true},
+
+		// Missing fields
+		{expr: `missing == 1`},
+		{expr: `missing != 1`, match: true},
+		{expr: `missing > 1`},
+		{expr: `missing contains "a"`},
+		{expr: `from.missing matches "*"`},
+		{expr: `list.1.v == 3`},
+		{expr: `list.x.v == 3`},
+		{expr: `n.x == 1`},
+
+		// Type mismatches
+		{expr: `n == "2"`},
+		{expr: `from.worker > 1`},
+		{expr: `n matches "*"`},
+		{expr: `n contains 2`},
+		{expr: `text contains 1`},
+		{expr: `b == 1`},
+
+		// Invalid payloads
+		{expr: `n == 2`, payload: json.RawMessage(`{"n":2`)},
+		{expr: `n == 2`, payload: json.RawMessage(`{"n":2} x`)},
+		{expr: `n == 2`, payload: json.RawMessage(`[2]`)},
+		{expr: `n != 2`, payload: json.RawMessage(`"n"`), match: true},
+	} {
+		f, err := ParseFilter(c.expr)
+		if err != nil {
+			t.Errorf("parsing %s failed: %v", c.expr, err)
+			continue
+		}
+		payload := p
+		if c.payload != nil {
+			payload = c.payload
+		}
+		if m := f.Match(payload); m != c.match {
+			t.Errorf("expected %s to match %s: %v, got %v", c.expr, payload, c.match, m)
+		}
+	}
+}
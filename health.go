@@ -0,0 +1,77 @@
+package astibob
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Health statuses, from best to worst
+const (
+	HealthyHealthStatus   = "healthy"
+	DegradedHealthStatus  = "degraded"
+	UnhealthyHealthStatus = "unhealthy"
+)
+
+// HealthChecker is an optional interface runnables can implement to tell whether they're able to do their job while
+// they're running, for instance when a model or a binary is missing
+type HealthChecker interface {
+	Health(ctx context.Context) Health
+}
+
+// Health is the result of a health check
+type Health struct {
+	CheckedAt time.Time         `json:"checked_at"`
+	Details   map[string]string `json:"details,omitempty"`
+	Message   string            `json:"message,omitempty"`
+	Status    string            `json:"status"`
+}
+
+// Healthy returns whether the status is healthy, the zero value being considered as such
+func (h Health) Healthy() bool {
+	return h.Status == "" || h.Status == HealthyHealthStatus
+}
+
+// WorkerHealth is the health of a worker's running runnables
+type WorkerHealth struct {
+	Name      string            `json:"name"`
+	Runnables map[string]Health `json:"runnables"` // Indexed by name
+	Status    string            `json:"status"`
+}
+
+// HealthReport is the health of the workers registered to the index
+type HealthReport struct {
+	Status  string         `json:"status"`
+	Workers []WorkerHealth `json:"workers"`
+}
+
+func healthStatusRank(s string) int {
+	switch s {
+	case DegradedHealthStatus:
+		return 1
+	case UnhealthyHealthStatus:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// WorstHealthStatus returns the worst of the statuses, or healthy if there's none
+func WorstHealthStatus(ss ...string) (o string) {
+	o = HealthyHealthStatus
+	for _, s := range ss {
+		if healthStatusRank(s) > healthStatusRank(o) {
+			o = s
+		}
+	}
+	return
+}
+
+// WriteHTTPHealth writes the data with a 503 status code when the status is unhealthy so that load balancers and
+// orchestrators can rely on the status code only
+func WriteHTTPHealth(rw http.ResponseWriter, status string, data interface{}) {
+	if status == UnhealthyHealthStatus {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	WriteHTTPData(rw, data)
+}
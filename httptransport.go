@@ -0,0 +1,667 @@
+package astibob
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// HTTPTransportOptions are the options of an HTTP transport
+type HTTPTransportOptions struct {
+	Index ServerOptions // Used by workers to dial the index
+}
+
+// HTTPTransport is the default transport. Workers and the index exchange messages through a websocket served by the
+// index on "/websockets/worker". Workers send messages to each other through a persistent websocket served by each
+// worker on "/websockets/messages", which is dialed the first time a message is sent to a worker and redialed in the
+// background whenever it's lost. Messages are written without waiting for the previous ones to be acked: the receiving
+// worker answers each of them with an ack frame carrying the id of the message on the link and the error returned by
+// its handler, if any. Acks only confirm that the other worker has accepted the message, not that its runnables have
+// handled it, and ack errors are logged since the message has already been reported as sent. Messages that must be
+// handled are sent with the at-least-once delivery mode instead. Workers still accept messages sent with a POST
+// request to "/api/messages", in which case the codec is picked from the Content-Type header and handler errors are
+// returned in the response.
+//
+// JSON payloads are sent in text frames and other payloads in binary frames. Since MessagePack is the only binary
+// codec, binary frames are unmarshaled with it.
+type HTTPTransport struct {
+	ics map[*httpTransportConn]bool   // Connections of workers to the index
+	ih  *TransportHandlers            // Index handlers
+	lcs map[*httpTransportConn]bool   // Links of other workers
+	ls  map[string]*httpTransportLink // Links to other workers indexed by name
+	m   *sync.Mutex                   // Locks ih and wh
+	mc  *sync.Mutex                   // Locks ics and lcs
+	ml  *sync.Mutex                   // Locks ls
+	o   HTTPTransportOptions
+	u   *websocket.Upgrader
+	wh  TransportHandler // Worker handler
+}
+
+// NewHTTPTransport creates a new HTTP transport
+func NewHTTPTransport(o HTTPTransportOptions) *HTTPTransport {
+	return &HTTPTransport{
+		ics: make(map[*httpTransportConn]bool),
+		lcs: make(map[*httpTransportConn]bool),
+		ls:  make(map[string]*httpTransportLink),
+		m:   &sync.Mutex{},
+		mc:  &sync.Mutex{},
+		ml:  &sync.Mutex{},
+		o:   o,
+		u:   &websocket.Upgrader{},
+	}
+}
+
+// Peers that don't answer pings for this period are considered lost
+const (
+	httpTransportPingPeriod     = 30 * time.Second
+	httpTransportPongWait       = 2 * httpTransportPingPeriod
+	httpTransportRedialAttempts = 5
+	httpTransportWriteWait      = 10 * time.Second
+)
+
+// httpTransportMessageType returns the websocket frame type of a content type
+func httpTransportMessageType(contentType string) int {
+	if c, ok := CodecByContentType(contentType); ok && c.Name() != JSONCodecName {
+		return websocket.BinaryMessage
+	}
+	return websocket.TextMessage
+}
+
+// httpTransportContentType returns the content type of a websocket frame type
+func httpTransportContentType(messageType int) string {
+	if messageType == websocket.BinaryMessage {
+		return codecs[MessagePackCodecName].ContentType()
+	}
+	return DefaultCodec().ContentType()
+}
+
+type httpTransportConn struct {
+	as map[uint64]bool // Ids of the payloads waiting for an ack
+	c  *websocket.Conn
+	id uint64      // Id of the last payload written with an ack
+	m  *sync.Mutex // Locks id and writes
+	ma *sync.Mutex // Locks as
+}
+
+func newHTTPTransportConn(c *websocket.Conn) *httpTransportConn {
+	return &httpTransportConn{
+		as: make(map[uint64]bool),
+		c:  c,
+		m:  &sync.Mutex{},
+		ma: &sync.Mutex{},
+	}
+}
+
+func (c *httpTransportConn) Close() error {
+	return c.c.Close()
+}
+
+func (c *httpTransportConn) Write(contentType string, p []byte) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.write(contentType, p)
+}
+
+// Assumes the mutex is held
+func (c *httpTransportConn) write(contentType string, p []byte) (err error) {
+	// Set write deadline
+	if err = c.c.SetWriteDeadline(time.Now().Add(httpTransportWriteWait)); err != nil {
+		err = errors.Wrap(err, "astibob: setting write deadline failed")
+		return
+	}
+
+	// Write
+	if err = c.c.WriteMessage(httpTransportMessageType(contentType), p); err != nil {
+		err = errors.Wrap(err, "astibob: writing message failed")
+		return
+	}
+	return
+}
+
+// httpTransportAck is the frame a worker answers each message of a link with. Both sides count the messages of the
+// link, which is why the id of a message doesn't need to be sent with it.
+type httpTransportAck struct {
+	Error string `json:"error,omitempty"`
+	ID    uint64 `json:"id"`
+}
+
+// writeWithAck writes a payload whose ack is expected
+func (c *httpTransportConn) writeWithAck(contentType string, p []byte) (err error) {
+	// Lock
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	// Add pending ack
+	c.id++
+	id := c.id
+	c.ma.Lock()
+	c.as[id] = true
+	c.ma.Unlock()
+
+	// Write
+	if err = c.write(contentType, p); err != nil {
+		c.ma.Lock()
+		delete(c.as, id)
+		c.ma.Unlock()
+		return
+	}
+	return
+}
+
+func (c *httpTransportConn) writeAck(id uint64, err error) error {
+	// Create ack
+	a := httpTransportAck{ID: id}
+	if err != nil {
+		a.Error = err.Error()
+	}
+
+	// Marshal
+	b, err := json.Marshal(a)
+	if err != nil {
+		return errors.Wrap(err, "astibob: marshaling ack failed")
+	}
+
+	// Write
+	return c.Write(DefaultCodec().ContentType(), b)
+}
+
+// handleAck returns the error carried by the ack
+func (c *httpTransportConn) handleAck(p []byte) (err error) {
+	// Unmarshal
+	var a httpTransportAck
+	if err = json.Unmarshal(p, &a); err != nil {
+		err = errors.Wrapf(err, "astibob: unmarshaling ack %s failed", p)
+		return
+	}
+
+	// Remove pending ack
+	c.ma.Lock()
+	_, ok := c.as[a.ID]
+	delete(c.as, a.ID)
+	c.ma.Unlock()
+
+	// No pending ack
+	if !ok {
+		err = fmt.Errorf("astibob: received ack %d without pending message", a.ID)
+		return
+	}
+
+	// Handler failed
+	if a.Error != "" {
+		err = errors.New(a.Error)
+		return
+	}
+	return
+}
+
+// Payloads whose ack hasn't been received may or may not have been accepted. It returns their number.
+func (c *httpTransportConn) failAcks() (n int) {
+	c.ma.Lock()
+	defer c.ma.Unlock()
+	n = len(c.as)
+	c.as = make(map[uint64]bool)
+	return
+}
+
+// read reads payloads and pings the peer until an error occurs
+func (c *httpTransportConn) read(fn func(contentType string, p []byte)) (err error) {
+	// Extend the read deadline whenever the peer answers a ping
+	c.c.SetReadDeadline(time.Now().Add(httpTransportPongWait))
+	c.c.SetPongHandler(func(string) error {
+		return c.c.SetReadDeadline(time.Now().Add(httpTransportPongWait))
+	})
+
+	// Ping
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		t := time.NewTicker(httpTransportPingPeriod)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if err := c.c.WriteControl(websocket.PingMessage, nil, time.Now().Add(httpTransportWriteWait)); err != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Loop
+	for {
+		// Read
+		var t int
+		var p []byte
+		if t, p, err = c.c.ReadMessage(); err != nil {
+			return
+		}
+
+		// Callback
+		fn(httpTransportContentType(t), p)
+	}
+}
+
+// Normal closures are not errors
+func isHTTPTransportNormalClosure(err error) bool {
+	v, ok := errors.Cause(err).(*websocket.CloseError)
+	return ok && (v.Code == websocket.CloseNormalClosure || v.Code == websocket.CloseNoStatusReceived)
+}
+
+func (t *HTTPTransport) addConn(cs map[*httpTransportConn]bool, c *httpTransportConn) {
+	t.mc.Lock()
+	defer t.mc.Unlock()
+	cs[c] = true
+}
+
+func (t *HTTPTransport) delConn(cs map[*httpTransportConn]bool, c *httpTransportConn) {
+	t.mc.Lock()
+	defer t.mc.Unlock()
+	delete(cs, c)
+}
+
+func (t *HTTPTransport) closeConns(cs map[*httpTransportConn]bool) {
+	t.mc.Lock()
+	defer t.mc.Unlock()
+	for c := range cs {
+		c.Close()
+	}
+}
+
+// DialIndex implements the Transport interface
+func (t *HTTPTransport) DialIndex(ctx context.Context, hs TransportHandlers) {
+	// Create header
+	h := make(http.Header)
+	if t.o.Index.Password != "" && t.o.Index.Username != "" {
+		h.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(t.o.Index.Username+":"+t.o.Index.Password)))
+	}
+
+	// Loop
+	addr := "ws://" + t.o.Index.Addr + "/websockets/worker"
+	for {
+		// Context is done
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Dial
+		wc, _, err := websocket.DefaultDialer.DialContext(ctx, addr, h)
+		if err != nil {
+			if ctx.Err() == nil {
+				astilog.Error(errors.Wrapf(err, "astibob: dialing %s failed", addr))
+			}
+			sleepTransportRetry(ctx)
+			continue
+		}
+		c := newHTTPTransportConn(wc)
+
+		// Close connection when the context is done
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				c.Close()
+			case <-done:
+			}
+		}()
+
+		// Open
+		if err = hs.open(c); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: opening connection failed"))
+		}
+
+		// Read
+		if err = c.read(func(contentType string, p []byte) {
+			if err := hs.message(c, contentType, p); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: handling index message failed"))
+			}
+		}); err != nil {
+			if isHTTPTransportNormalClosure(err) {
+				astilog.Info("astibob: worker has disconnected from index")
+			} else if ctx.Err() == nil {
+				astilog.Error(errors.Wrap(err, "astibob: reading websocket failed"))
+			}
+		}
+
+		// Close
+		close(done)
+		c.Close()
+		hs.close(c)
+
+		// Wait before reconnecting
+		sleepTransportRetry(ctx)
+	}
+}
+
+// ListenIndex implements the Transport interface
+func (t *HTTPTransport) ListenIndex(ctx context.Context, hs TransportHandlers) error {
+	// Store handlers
+	t.m.Lock()
+	t.ih = &hs
+	t.m.Unlock()
+
+	// Close connections when the context is done
+	go func() {
+		<-ctx.Done()
+		t.closeConns(t.ics)
+	}()
+	return nil
+}
+
+// ListenWorker implements the Transport interface
+func (t *HTTPTransport) ListenWorker(ctx context.Context, name string, h TransportHandler) error {
+	// Store handler
+	t.m.Lock()
+	t.wh = h
+	t.m.Unlock()
+
+	// Close links when the context is done
+	go func() {
+		<-ctx.Done()
+		t.closeConns(t.lcs)
+	}()
+	return nil
+}
+
+// SendToWorker implements the Transport interface. Messages sent to the same worker share the same link and are
+// reported as sent once they've been written to it.
+func (t *HTTPTransport) SendToWorker(ctx context.Context, name, addr, contentType string, p []byte) (err error) {
+	// Write
+	if err = t.link(ctx, name, addr).write(ctx, contentType, p); err != nil {
+		err = errors.Wrapf(err, "astibob: writing to worker %s failed", name)
+		return
+	}
+	return
+}
+
+func (t *HTTPTransport) link(ctx context.Context, name, addr string) (l *httpTransportLink) {
+	// Lock
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	// Link exists
+	var ok bool
+	if l, ok = t.ls[name]; ok {
+		// Same address
+		if l.addr == addr {
+			return
+		}
+
+		// The worker has registered again with another address
+		l.close()
+	}
+
+	// Create link
+	l = newHTTPTransportLink(ctx, name, addr)
+	t.ls[name] = l
+
+	// Close link when the context is done
+	go func() {
+		<-ctx.Done()
+		l.close()
+	}()
+	return
+}
+
+// A link is a websocket to another worker. It's dialed lazily and redialed in the background when it's lost, until
+// it's closed or the number of redial attempts has been reached, in which case it's dialed again with the next
+// message.
+type httpTransportLink struct {
+	addr   string
+	c      *httpTransportConn
+	closed bool
+	ctx    context.Context
+	m      *sync.Mutex // Locks c and closed
+	name   string
+}
+
+func newHTTPTransportLink(ctx context.Context, name, addr string) *httpTransportLink {
+	return &httpTransportLink{
+		addr: addr,
+		ctx:  ctx,
+		m:    &sync.Mutex{},
+		name: name,
+	}
+}
+
+// write writes the payload without waiting for the other worker to ack it
+func (l *httpTransportLink) write(ctx context.Context, contentType string, p []byte) (err error) {
+	// Lock
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	// Link is closed
+	if l.closed {
+		err = errors.New("astibob: link is closed")
+		return
+	}
+
+	// The link may have been closed by the other worker since the previous message, in which case we retry once with
+	// a new connection
+	for idx := 0; idx < 2; idx++ {
+		// Context is done
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		// Dial
+		if l.c == nil {
+			if err = l.dial(ctx); err != nil {
+				err = errors.Wrap(err, "astibob: dialing failed")
+				return
+			}
+		}
+
+		// Write
+		if err = l.c.writeWithAck(contentType, p); err == nil {
+			return
+		}
+
+		// Reset connection
+		l.c.Close()
+		l.c = nil
+	}
+	return
+}
+
+// Assumes the mutex is held
+func (l *httpTransportLink) dial(ctx context.Context) (err error) {
+	// Dial
+	addr := websocketAddr(l.addr) + "/websockets/messages"
+	var wc *websocket.Conn
+	if wc, _, err = websocket.DefaultDialer.DialContext(ctx, addr, nil); err != nil {
+		err = errors.Wrapf(err, "astibob: dialing %s failed", addr)
+		return
+	}
+	c := newHTTPTransportConn(wc)
+	l.c = c
+
+	// Read acks so that the link also notices when it's lost
+	go func() {
+		// Read
+		err := c.read(func(_ string, p []byte) {
+			if err := c.handleAck(p); err != nil {
+				astilog.Error(errors.Wrapf(err, "astibob: worker %s didn't accept message", l.name))
+			}
+		})
+		if err != nil && !isHTTPTransportNormalClosure(err) {
+			astilog.Debug(errors.Wrapf(err, "astibob: reading %s failed", addr))
+		}
+
+		// Fail pending acks
+		if n := c.failAcks(); n > 0 {
+			astilog.Warnf("astibob: link to worker %s has been lost before %d message(s) have been acked", l.name, n)
+		}
+
+		// Reset connection
+		l.m.Lock()
+		lost := l.c == c
+		if lost {
+			l.c = nil
+		}
+		l.m.Unlock()
+		c.Close()
+
+		// Redial
+		if lost {
+			l.redial()
+		}
+	}()
+	return
+}
+
+func (l *httpTransportLink) redial() {
+	for idx := 0; idx < httpTransportRedialAttempts; idx++ {
+		// Wait
+		sleepTransportRetry(l.ctx)
+
+		// Lock
+		l.m.Lock()
+
+		// Link is closed or has already been dialed
+		if l.closed || l.ctx.Err() != nil || l.c != nil {
+			l.m.Unlock()
+			return
+		}
+
+		// Dial
+		err := l.dial(l.ctx)
+		l.m.Unlock()
+		if err == nil {
+			return
+		}
+		astilog.Debug(errors.Wrap(err, "astibob: redialing failed"))
+	}
+}
+
+func (l *httpTransportLink) close() {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.closed = true
+	if l.c != nil {
+		l.c.Close()
+		l.c = nil
+	}
+}
+
+// Converts an HTTP address into a websocket address
+func websocketAddr(addr string) string {
+	if strings.HasPrefix(addr, "https://") {
+		return "wss://" + strings.TrimPrefix(addr, "https://")
+	}
+	return "ws://" + strings.TrimPrefix(addr, "http://")
+}
+
+// Routes implements the RoutedTransport interface. Only routes of what the transport listens to are returned.
+func (t *HTTPTransport) Routes() (rs map[string]map[string]httprouter.Handle) {
+	// Lock
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// Add routes
+	rs = make(map[string]map[string]httprouter.Handle)
+	if t.ih != nil {
+		rs["/websockets/worker"] = map[string]httprouter.Handle{http.MethodGet: t.handleWorkerWebsocket(*t.ih)}
+	}
+	if t.wh != nil {
+		rs["/api/messages"] = map[string]httprouter.Handle{http.MethodPost: t.handleWorkerMessage(t.wh)}
+		rs["/websockets/messages"] = map[string]httprouter.Handle{http.MethodGet: t.handleWorkerLink(t.wh)}
+	}
+	return
+}
+
+func (t *HTTPTransport) handleWorkerMessage(h TransportHandler) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Read body
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "astibob: reading body failed"))
+			return
+		}
+
+		// Handle
+		if err = h(r.Header.Get("Content-Type"), b); err != nil {
+			WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "astibob: handling message failed"))
+			return
+		}
+	}
+}
+
+func (t *HTTPTransport) handleWorkerLink(h TransportHandler) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Upgrade
+		wc, err := t.u.Upgrade(rw, r, nil)
+		if err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: upgrading worker link failed"))
+			return
+		}
+		c := newHTTPTransportConn(wc)
+
+		// Store connection so that it's closed when the transport stops listening
+		t.addConn(t.lcs, c)
+		defer t.delConn(t.lcs, c)
+
+		// Read
+		// Errors returned by the handler are sent back to the other worker together with the id of the message
+		var id uint64
+		if err = c.read(func(contentType string, p []byte) {
+			id++
+			if err := c.writeAck(id, h(contentType, p)); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: writing ack failed"))
+			}
+		}); err != nil && !isHTTPTransportNormalClosure(err) {
+			astilog.Debug(errors.Wrap(err, "astibob: reading worker link failed"))
+		}
+
+		// Close
+		c.Close()
+	}
+}
+
+func (t *HTTPTransport) handleWorkerWebsocket(hs TransportHandlers) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Upgrade
+		wc, err := t.u.Upgrade(rw, r, nil)
+		if err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: upgrading worker websocket failed"))
+			return
+		}
+		c := newHTTPTransportConn(wc)
+
+		// Store connection so that it's closed when the transport stops listening
+		t.addConn(t.ics, c)
+		defer t.delConn(t.ics, c)
+
+		// Open
+		if err = hs.open(c); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: opening connection failed"))
+			c.Close()
+			return
+		}
+
+		// Read
+		if err = c.read(func(contentType string, p []byte) {
+			if err := hs.message(c, contentType, p); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: handling worker message failed"))
+			}
+		}); err != nil && !isHTTPTransportNormalClosure(err) {
+			astilog.Error(errors.Wrap(err, "astibob: reading worker websocket failed"))
+		}
+
+		// Close
+		c.Close()
+		hs.close(c)
+	}
+}
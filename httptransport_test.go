@@ -0,0 +1,78 @@
+package astibob
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestHTTPTransportLink(t *testing.T) {
+	// Listen
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tr := NewHTTPTransport(HTTPTransportOptions{})
+	var ps []string
+	m := &sync.Mutex{} // Locks ps
+	c := make(chan bool)
+	tr.ListenWorker(ctx, "worker", func(contentType string, p []byte) error {
+		// Wait for all messages to be sent
+		<-c
+
+		// Store payload
+		m.Lock()
+		ps = append(ps, string(p))
+		m.Unlock()
+
+		// Handler errors are acked as well
+		if string(p) == "2" {
+			return errors.New("test")
+		}
+		return nil
+	})
+
+	// Serve
+	r := httprouter.New()
+	for p, hs := range tr.Routes() {
+		for method, h := range hs {
+			r.Handle(method, p, h)
+		}
+	}
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	// Messages are sent without waiting for the previous ones to be acked
+	for _, p := range []string{"1", "2", "3"} {
+		if err := tr.SendToWorker(ctx, "worker", s.URL, DefaultCodec().ContentType(), []byte(p)); err != nil {
+			t.Fatalf("sending %s failed: %v", p, err)
+		}
+	}
+	close(c)
+
+	// Messages are acked in order
+	l := tr.link(ctx, "worker", s.URL)
+	for d := time.Now().Add(time.Second); ; {
+		l.m.Lock()
+		lc := l.c
+		l.m.Unlock()
+		lc.ma.Lock()
+		n := len(lc.as)
+		lc.ma.Unlock()
+		if n == 0 {
+			break
+		} else if time.Now().After(d) {
+			t.Fatalf("expected messages to be acked, %d are pending", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	m.Lock()
+	defer m.Unlock()
+	if e := []string{"1", "2", "3"}; !reflect.DeepEqual(ps, e) {
+		t.Errorf("expected %v, got %v", e, ps)
+	}
+}
@@ -0,0 +1,117 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Maximum duration the index waits for a worker to apply a config update
+const configUpdateTimeout = 10 * time.Second
+
+func (i *Index) updateRunnableConfig(m *astibob.Message) (err error) {
+	return i.updateRunnable(m, func(r *astibob.RunnableMessage) {
+		if r.Config != nil {
+			r.Config = &astibob.RunnableConfig{
+				Schema: r.Config.Schema,
+				Values: m.Payload,
+			}
+		}
+	})
+}
+
+func (i *Index) runnableConfig(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Unescape worker
+	worker, err := url.QueryUnescape(p.ByName("worker"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping worker failed"))
+		return
+	}
+
+	// Unescape runnable
+	runnable, err := url.QueryUnescape(p.ByName("runnable"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping runnable failed"))
+		return
+	}
+
+	// Get worker
+	i.mw.Lock()
+	w, ok := i.ws[worker]
+	i.mw.Unlock()
+
+	// No worker
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Get runnable
+	w.mr.Lock()
+	rm, ok := w.rs[runnable]
+	w.mr.Unlock()
+
+	// No runnable or runnable is not configurable
+	if !ok || rm.Config == nil {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Write
+	astibob.WriteHTTPData(rw, rm.Config)
+}
+
+func (i *Index) updateRunnableConfigFromAPI(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Unescape worker
+	worker, err := url.QueryUnescape(p.ByName("worker"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping worker failed"))
+		return
+	}
+
+	// Unescape runnable
+	runnable, err := url.QueryUnescape(p.ByName("runnable"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping runnable failed"))
+		return
+	}
+
+	// Read body
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "index: reading body failed"))
+		return
+	}
+
+	// Invalid body
+	if !json.Valid(b) {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.New("index: body is not valid JSON"))
+		return
+	}
+
+	// Create context
+	ctx, cancel := context.WithTimeout(r.Context(), configUpdateTimeout)
+	defer cancel()
+
+	// Request
+	var m *astibob.Message
+	if m, err = i.Request(ctx, worker, runnable, astibob.RunnableConfigUpdateMessage, json.RawMessage(b)); err != nil {
+		if v, ok := errors.Cause(err).(*astibob.Error); ok {
+			rw.WriteHeader(http.StatusBadRequest)
+			astibob.WriteHTTPData(rw, v)
+		} else {
+			astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "index: requesting failed"))
+		}
+		return
+	}
+
+	// Write
+	rw.Write(m.Payload)
+}
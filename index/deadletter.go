@@ -0,0 +1,15 @@
+package index
+
+import (
+	"github.com/asticode/go-astibob"
+)
+
+// DeadLetters returns the messages whose handler returned an error or panicked
+func (i *Index) DeadLetters() []astibob.DeadLetter {
+	return i.d.DeadLetters()
+}
+
+// ReplayDeadLetter hands the message of a dead letter over to its handler again
+func (i *Index) ReplayDeadLetter(id int) error {
+	return i.d.ReplayDeadLetter(id)
+}
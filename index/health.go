@@ -0,0 +1,50 @@
+package index
+
+import (
+	"net/http"
+
+	"github.com/asticode/go-astibob"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Health returns the health of the running runnables implementing astibob.HealthChecker, as last reported by their
+// workers
+func (i *Index) Health() (h astibob.HealthReport) {
+	// Create report
+	h.Workers = []astibob.WorkerHealth{}
+
+	// Loop through workers
+	var ss []string
+	for _, w := range i.workers() {
+		// Create worker health
+		wh := astibob.WorkerHealth{
+			Name:      w.Name,
+			Runnables: make(map[string]astibob.Health),
+		}
+
+		// Loop through runnables
+		var rss []string
+		for _, r := range w.Runnables {
+			if r.Health != nil {
+				wh.Runnables[r.Name] = *r.Health
+				rss = append(rss, r.Health.Status)
+			}
+		}
+
+		// Get status
+		wh.Status = astibob.WorstHealthStatus(rss...)
+		ss = append(ss, wh.Status)
+
+		// Append
+		h.Workers = append(h.Workers, wh)
+	}
+
+	// Get status
+	h.Status = astibob.WorstHealthStatus(ss...)
+	return
+}
+
+func (i *Index) health(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	h := i.Health()
+	astibob.WriteHTTPHealth(rw, h.Status, h)
+}
@@ -1,7 +1,7 @@
 package index
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 	"sort"
 	"sync"
@@ -15,44 +15,99 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Number of transitions kept per runnable when none is provided
+const defaultRunnableTransitions = 10
+
 type Options struct {
-	Server astibob.ServerOptions `toml:"server"`
+	Dispatcher          astibob.DispatcherOptions `toml:"dispatcher"`
+	HTTPClient          *http.Client              `toml:"-"` // Used to reach the servers of workers, defaults to a new client
+	Recorder            astibob.RecorderOptions   `toml:"recorder"`
+	RunnableTransitions int                       `toml:"runnable_transitions"` // Number of transitions kept per runnable, defaults to 10
+	Server              astibob.ServerOptions     `toml:"server"`
+	Transport           astibob.Transport         `toml:"-"` // Defaults to the HTTP transport
 }
 
 type Index struct {
-	c  *http.Client
-	d  *astibob.Dispatcher
-	mu *sync.Mutex // Locks us
-	mw *sync.Mutex // Locks ws
-	o  Options
-	r  *resources
-	t  *astitemplate.Templater
-	us map[string]map[string]bool // UI message names indexed by message --> ui
-	w  *astiworker.Worker
-	ws map[string]*worker // Workers indexed by name
-	wu *astiws.Manager
-	ww *astiws.Manager
+	c   *http.Client
+	d   *astibob.Dispatcher
+	ds  map[int]*pendingRequest // Pending requests indexed by message id
+	id  int
+	m   *astibob.Metrics
+	md  *sync.Mutex // Locks ds
+	mi  *sync.Mutex // Locks id
+	mu  *sync.Mutex // Locks us
+	mw  *sync.Mutex // Locks wcs and ws
+	o   Options
+	r   *resources
+	rc  *astibob.Recorder
+	t   *astitemplate.Templater
+	tr  astibob.Transport
+	us  map[string]map[string]bool // UI message names indexed by message --> ui
+	w   *astiworker.Worker
+	wcs map[string]astibob.TransportConn // Worker connections indexed by name
+	ws  map[string]*worker               // Workers indexed by name
+	wu  *astiws.Manager
 }
 
 // New creates a new index
 func New(o Options) (i *Index, err error) {
 	// Create index
 	i = &Index{
-		c:  &http.Client{},
-		mu: &sync.Mutex{},
-		mw: &sync.Mutex{},
-		o:  o,
-		r:  newResources(),
-		t:  astitemplate.NewTemplater(),
-		us: make(map[string]map[string]bool),
-		w:  astiworker.NewWorker(),
-		ws: make(map[string]*worker),
-		wu: astiws.NewManager(astiws.ManagerConfiguration{}),
-		ww: astiws.NewManager(astiws.ManagerConfiguration{}),
+		c:   o.HTTPClient,
+		ds:  make(map[int]*pendingRequest),
+		m:   astibob.NewMetrics(),
+		md:  &sync.Mutex{},
+		mi:  &sync.Mutex{},
+		mu:  &sync.Mutex{},
+		mw:  &sync.Mutex{},
+		o:   o,
+		r:   newResources(),
+		t:   astitemplate.NewTemplater(),
+		tr:  o.Transport,
+		us:  make(map[string]map[string]bool),
+		w:   astiworker.NewWorker(),
+		wcs: make(map[string]astibob.TransportConn),
+		ws:  make(map[string]*worker),
+		wu:  astiws.NewManager(astiws.ManagerConfiguration{}),
+	}
+
+	// Default options
+	if i.o.RunnableTransitions <= 0 {
+		i.o.RunnableTransitions = defaultRunnableTransitions
+	}
+
+	// Default http client
+	if i.c == nil {
+		i.c = &http.Client{}
+	}
+
+	// Default transport
+	if i.tr == nil {
+		i.tr = astibob.NewHTTPTransport(astibob.HTTPTransportOptions{})
 	}
 
 	// Create dispatcher
 	i.d = astibob.NewDispatcher(i.w.Context(), i.w.NewTask)
+	i.d.SetOptions(o.Dispatcher)
+	i.d.OnOverflow(i.sendDispatcherOverflow)
+
+	// Add metrics
+	i.addMetrics()
+
+	// Add recorder
+	if err = i.addRecorder(); err != nil {
+		err = errors.Wrap(err, "index: adding recorder failed")
+		return
+	}
+
+	// Listen to workers
+	if err = i.tr.ListenIndex(i.w.Context(), astibob.TransportHandlers{
+		OnClose:   i.onWorkerClose,
+		OnMessage: i.handleWorkerMessage,
+	}); err != nil {
+		err = errors.Wrap(err, "index: listening to workers failed")
+		return
+	}
 
 	// Loop through layouts
 	for _, c := range i.r.layouts() {
@@ -66,15 +121,24 @@ func New(o Options) (i *Index, err error) {
 
 	// Add dispatcher handlers
 	i.d.On(astibob.DispatchConditions{Names: map[string]bool{
-		astibob.RunnableCrashedMessage: true,
-		astibob.RunnableStartedMessage: true,
-		astibob.RunnableStoppedMessage: true,
+		astibob.RunnableCrashedMessage:  true,
+		astibob.RunnableStartedMessage:  true,
+		astibob.RunnableStartingMessage: true,
+		astibob.RunnableStoppedMessage:  true,
+		astibob.RunnableStoppingMessage: true,
 	}}, i.updateRunnableStatus)
+	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableConfigUpdatedMessage)}, i.updateRunnableConfig)
+	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableHealthMessage)}, i.updateRunnableHealth)
+	i.d.On(astibob.DispatchConditions{
+		Name: astiptr.Str(astibob.RunnableDoneMessage),
+		To:   astibob.NewIndexIdentifier(),
+	}, i.doneMessage)
 	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.UIDisconnectedMessage)}, i.unregisterUI)
 	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.UIPingMessage)}, i.extendUIConnection)
 	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.UIRegisterMessage)}, i.registerUI)
 	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerDisconnectedMessage)}, i.delWorker)
 	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerRegisterMessage)}, i.addWorker)
+	i.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerUpdateMessage)}, i.updateWorker)
 	i.d.On(astibob.DispatchConditions{To: &astibob.Identifier{Types: map[string]bool{
 		astibob.RunnableIdentifierType: true,
 		astibob.WorkerIdentifierType:   true,
@@ -83,11 +147,31 @@ func New(o Options) (i *Index, err error) {
 	return
 }
 
+// DispatcherStats returns the stats of the dispatcher queues
+func (i *Index) DispatcherStats() []astibob.DispatcherQueueStats {
+	return i.d.Stats()
+}
+
+func (i *Index) sendDispatcherOverflow(o astibob.DispatcherOverflow) {
+	// Create message
+	m, err := astibob.NewDispatcherOverflowMessage(*astibob.NewIndexIdentifier(), &astibob.Identifier{Type: astibob.UIIdentifierType}, o)
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "index: creating dispatcher overflow message failed"))
+		return
+	}
+
+	// Dispatch
+	i.d.Dispatch(m)
+}
+
 // Close closes the index properly
 func (i *Index) Close() error {
 	// Close dispatcher
 	i.d.Close()
 
+	// Close recorder
+	i.closeRecorder()
+
 	// Close ui clients
 	if i.wu != nil {
 		if err := i.wu.Close(); err != nil {
@@ -95,12 +179,14 @@ func (i *Index) Close() error {
 		}
 	}
 
-	// Close worker clients
-	if i.ww != nil {
-		if err := i.ww.Close(); err != nil {
-			astilog.Error(errors.Wrap(err, "index: closing worker clients failed"))
+	// Close worker connections
+	i.mw.Lock()
+	for name, c := range i.wcs {
+		if err := c.Close(); err != nil {
+			astilog.Error(errors.Wrapf(err, "index: closing worker %s connection failed", name))
 		}
 	}
+	i.mw.Unlock()
 	return nil
 }
 
@@ -109,50 +195,86 @@ func (i *Index) HandleSignals() {
 	i.w.HandleSignals()
 }
 
+// Stop stops the index
+func (i *Index) Stop() {
+	i.w.Stop()
+}
+
 // Wait waits for the index to be stopped
 func (i *Index) Wait() {
 	i.w.Wait()
 }
 
-// On makes sure to handle messages with specific conditions
-func (i *Index) On(c astibob.DispatchConditions, h astibob.MessageHandler) {
-	i.d.On(c, h)
+// On makes sure to handle messages with specific conditions until the returned subscription is turned off
+func (i *Index) On(c astibob.DispatchConditions, h astibob.MessageHandler) *astibob.Subscription {
+	return i.d.On(c, h)
 }
 
-func sendMessage(m *astibob.Message, label string, wm *astiws.Manager, names ...string) (err error) {
-	// Get clients
-	var cs []*astiws.Client
-	if len(names) > 0 {
-		// Loop through names
-		for _, name := range names {
-			// Retrieve client from manager
-			c, ok := wm.Client(name)
-			if !ok {
-				err = fmt.Errorf("index: client %s doesn't exist", name)
-				return
-			}
+// Use adds a middleware wrapping all handlers, including built-in ones, until the returned subscription is turned off
+func (i *Index) Use(m astibob.Middleware) *astibob.Subscription {
+	return i.d.Use(m)
+}
+
+// UseOn adds a middleware wrapping all handlers of messages matching the conditions, including built-in ones, until the
+// returned subscription is turned off
+func (i *Index) UseOn(c astibob.DispatchConditions, m astibob.Middleware) *astibob.Subscription {
+	return i.d.UseOn(c, m)
+}
+
+// Scope creates a scope whose handlers are all removed together, at the latest when the context is done
+func (i *Index) Scope(ctx context.Context) *astibob.Scope {
+	return i.d.Scope(ctx)
+}
 
-			// Append client
-			cs = append(cs, c)
+// Writers are indexed by name
+// messageWriter writes a payload marshaled with the codec of the provided content type
+type messageWriter func(contentType string, p []byte) error
+
+func (i *Index) sendMessage(m *astibob.Message, label string, ws map[string]messageWriter, cf func(name string) astibob.Codec) (err error) {
+	// Message has expired
+	// UI names are random which is why we don't use them as peers
+	if m.Expired() {
+		astilog.Debugf("index: dropping expired message %s to %s", m.Name, label)
+		if label == "ui" {
+			i.m.MessageExpired(label)
+		} else {
+			for name := range ws {
+				i.m.MessageExpired(name)
+			}
 		}
-	} else {
-		// Loop through clients
-		wm.Clients(func(_ interface{}, c *astiws.Client) (err error) {
-			cs = append(cs, c)
-			return
-		})
+		return
 	}
 
-	// Loop through clients
-	for _, c := range cs {
+	// Loop through writers
+	bs := make(map[string][]byte) // Marshaled messages indexed by codec
+	for name, w := range ws {
+		// Marshal
+		cd := cf(name)
+		b, ok := bs[cd.Name()]
+		if !ok {
+			if b, err = cd.Marshal(m); err != nil {
+				err = errors.Wrapf(err, "index: marshaling with codec %s failed", cd.Name())
+				return
+			}
+			bs[cd.Name()] = b
+		}
+
 		// Log
-		astilog.Debugf("index: sending %s message to %s with client %p", m.Name, label, c)
+		astilog.Debugf("index: sending %s message to %s %s with codec %s", m.Name, label, name, cd.Name())
 
 		// Write
-		if err = c.WriteJSON(m); err != nil {
-			err = errors.Wrap(err, "index: writing JSON message failed")
+		if err = w(cd.ContentType(), b); err != nil {
+			err = errors.Wrap(err, "index: writing message failed")
 			return
 		}
+
+		// Update metrics
+		// UI names are random which is why we don't use them as peers
+		if label == "ui" {
+			i.m.MessageSent(label)
+		} else {
+			i.m.MessageSent(name)
+		}
 	}
 	return
 }
@@ -0,0 +1,49 @@
+package index
+
+import (
+	"github.com/asticode/go-astibob"
+)
+
+func (i *Index) addMetrics() {
+	// Measure handlers
+	i.d.Use(astibob.MetricsMiddleware(i.m))
+
+	// Collect
+	astibob.CollectDispatcherMetrics(i.m, i.d)
+	i.m.Collect(i.collectRunnableMetrics)
+}
+
+func (i *Index) collectRunnableMetrics() {
+	// Create metric
+	rm := i.m.Gauge(astibob.RunnableRunningMetric, "Whether runnables are running", "worker", "runnable")
+
+	// Reset
+	rm.Reset()
+
+	// Lock
+	i.mw.Lock()
+	defer i.mw.Unlock()
+
+	// Loop through workers
+	for _, w := range i.ws {
+		// Lock
+		w.mr.Lock()
+
+		// Loop through runnables
+		for n, r := range w.rs {
+			var v float64
+			if r.Status == astibob.RunningStatus {
+				v = 1
+			}
+			rm.Set(v, w.name, n)
+		}
+
+		// Unlock
+		w.mr.Unlock()
+	}
+}
+
+// Metrics returns the metrics exposed through the /api/metrics route
+func (i *Index) Metrics() *astibob.Metrics {
+	return i.m
+}
@@ -0,0 +1,74 @@
+package index
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+func (i *Index) addRecorder() (err error) {
+	// No path
+	if i.o.Recorder.Path == "" {
+		return
+	}
+
+	// Create recorder
+	if i.rc, err = astibob.NewRecorder(i.o.Recorder); err != nil {
+		err = errors.Wrap(err, "index: creating recorder failed")
+		return
+	}
+
+	// Record dispatched messages
+	i.d.SetRecorder(i.rc)
+	return
+}
+
+func (i *Index) closeRecorder() {
+	// No recorder
+	if i.rc == nil {
+		return
+	}
+
+	// Close
+	if err := i.rc.Close(); err != nil {
+		astilog.Error(errors.Wrap(err, "index: closing recorder failed"))
+	}
+}
+
+// Replay dispatches the messages of a recording matching the options as if they had just been received
+func (i *Index) Replay(ctx context.Context, r io.Reader, o astibob.ReplayOptions) error {
+	return astibob.Replay(ctx, r, o, func(m *astibob.Message) error {
+		i.d.Dispatch(m)
+		return nil
+	})
+}
+
+// Messages posted through the API are dispatched as if they had been received from their sender, which is how
+// recordings are replayed into a running index
+func (i *Index) handleAPIMessage(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Read body
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "index: reading body failed"))
+		return
+	}
+
+	// Unmarshal
+	var m *astibob.Message
+	if m, err = astibob.UnmarshalMessage(r.Header.Get("Content-Type"), b); err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unmarshaling failed"))
+		return
+	}
+
+	// Log
+	astilog.Debugf("index: handling api message %s", m.Name)
+
+	// Dispatch
+	i.d.Dispatch(m)
+}
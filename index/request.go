@@ -0,0 +1,206 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/asticode/go-astibob"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+type pendingRequest struct {
+	c      chan *astibob.Message
+	worker string
+}
+
+// Request sends a message to a runnable and waits for its reply. If the runnable returns an *astibob.Error it is
+// returned as is.
+func (i *Index) Request(ctx context.Context, worker, runnable, name string, payload interface{}) (r *astibob.Message, err error) {
+	// Create message
+	m := astibob.NewMessage()
+	m.From = *astibob.NewIndexIdentifier()
+	m.Name = name
+	m.To = astibob.NewRunnableIdentifier(runnable, worker)
+	m.Trace = astibob.NewTrace()
+
+	// Marshal payload
+	if payload != nil {
+		if m.Payload, err = json.Marshal(payload); err != nil {
+			err = errors.Wrap(err, "index: marshaling payload failed")
+			return
+		}
+	}
+
+	// Set id
+	i.mi.Lock()
+	i.id++
+	m.ID = i.id
+	i.mi.Unlock()
+
+	// Add pending request
+	pr := &pendingRequest{
+		c:      make(chan *astibob.Message, 1),
+		worker: worker,
+	}
+	i.md.Lock()
+	i.ds[m.ID] = pr
+	i.md.Unlock()
+
+	// Make sure to remove pending request
+	defer func() {
+		i.md.Lock()
+		delete(i.ds, m.ID)
+		i.md.Unlock()
+	}()
+
+	// Dispatch
+	i.d.Dispatch(m)
+
+	// Wait
+	var dm *astibob.Message
+	select {
+	case dm = <-pr.c:
+	case <-ctx.Done():
+		err = errors.Wrapf(ctx.Err(), "index: waiting for reply to message %s failed", name)
+		return
+	}
+
+	// Parse payload
+	var d astibob.RunnableDone
+	if d, err = astibob.ParseRunnableDonePayload(dm); err != nil {
+		err = errors.Wrap(err, "index: parsing runnable done payload failed")
+		return
+	}
+
+	// Error
+	if !d.Success {
+		if d.Error != nil {
+			err = d.Error
+		} else {
+			err = fmt.Errorf("index: runnable %s failed handling message %s", runnable, name)
+		}
+		return
+	}
+
+	// Create reply
+	r = &astibob.Message{
+		From:    dm.From,
+		ID:      d.ID,
+		Name:    name,
+		Payload: d.Payload,
+		To:      dm.To,
+	}
+	return
+}
+
+func (i *Index) doneMessage(m *astibob.Message) (err error) {
+	// Parse payload
+	var d astibob.RunnableDone
+	if d, err = astibob.ParseRunnableDonePayload(m); err != nil {
+		err = errors.Wrap(err, "index: parsing runnable done payload failed")
+		return
+	}
+
+	// Get pending request
+	i.md.Lock()
+	pr, ok := i.ds[d.ID]
+	i.md.Unlock()
+
+	// No pending request
+	if !ok {
+		return
+	}
+
+	// Send
+	select {
+	case pr.c <- m:
+	default:
+	}
+	return
+}
+
+func (i *Index) failPendingRequests(worker string) (err error) {
+	// Lock
+	i.md.Lock()
+	defer i.md.Unlock()
+
+	// Loop through pending requests
+	for id, pr := range i.ds {
+		// Different worker
+		if pr.worker != worker {
+			continue
+		}
+
+		// Create message
+		var m *astibob.Message
+		if m, err = astibob.NewRunnableDoneMessage(astibob.NewIndexIdentifier(), astibob.RunnableDone{
+			Error: astibob.NewError(astibob.WorkerDisconnectedErrorCode, fmt.Sprintf("index: worker %s has disconnected", worker)),
+			ID:    id,
+		}); err != nil {
+			err = errors.Wrap(err, "index: creating runnable done message failed")
+			return
+		}
+
+		// Send
+		select {
+		case pr.c <- m:
+		default:
+		}
+	}
+	return
+}
+
+type APIRequest struct {
+	Name    string          `json:"name"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func (i *Index) request(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Unescape worker
+	worker, err := url.QueryUnescape(p.ByName("worker"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping worker failed"))
+		return
+	}
+
+	// Unescape runnable
+	runnable, err := url.QueryUnescape(p.ByName("runnable"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping runnable failed"))
+		return
+	}
+
+	// Unmarshal
+	var b APIRequest
+	if err = json.NewDecoder(r.Body).Decode(&b); err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unmarshaling failed"))
+		return
+	}
+
+	// Create payload
+	var pl interface{}
+	if len(b.Payload) > 0 {
+		pl = b.Payload
+	}
+
+	// Request
+	var m *astibob.Message
+	if m, err = i.Request(r.Context(), worker, runnable, b.Name, pl); err != nil {
+		if v, ok := errors.Cause(err).(*astibob.Error); ok {
+			rw.WriteHeader(http.StatusBadRequest)
+			astibob.WriteHTTPData(rw, v)
+		} else {
+			astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "index: requesting failed"))
+		}
+		return
+	}
+
+	// Write
+	if len(m.Payload) > 0 {
+		rw.Write(m.Payload)
+	}
+}
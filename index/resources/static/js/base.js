@@ -38,11 +38,18 @@ let base = {
 
                                 // Get message names
                                 let ms = [
+                                    consts.messageNames.dispatcherOverflow,
                                     consts.messageNames.runnableCrashed,
+                                    consts.messageNames.runnableGaveUp,
+                                    consts.messageNames.runnableHealth,
+                                    consts.messageNames.runnableRestarting,
                                     consts.messageNames.runnableStarted,
+                                    consts.messageNames.runnableStarting,
                                     consts.messageNames.runnableStopped,
+                                    consts.messageNames.runnableStopping,
                                     consts.messageNames.workerDisconnected,
                                     consts.messageNames.workerRegistered,
+                                    consts.messageNames.workerUpdated,
                                 ]
 
                                 // Add custom message names
@@ -70,6 +77,29 @@ let base = {
                                     base.finish()
                                 }
                                 break
+                            case consts.messageNames.dispatcherOverflow:
+                                // Notify
+                                let from = typeof data.from.name !== "undefined" ? data.from.name : data.from.type
+                                asticode.notifier.warning(from + " dropped " + data.payload.dropped + " message(s) in queue " + data.payload.key)
+                                break
+                            case consts.messageNames.runnableCrashed:
+                                // Notify
+                                asticode.notifier.error(data.from.worker + " runnable " + data.from.name + " has crashed: " + data.payload.error)
+                                break
+                            case consts.messageNames.runnableGaveUp:
+                                // Notify
+                                asticode.notifier.error(data.from.worker + " gave up restarting " + data.from.name + " after " + data.payload.attempt + " restart(s)")
+                                break
+                            case consts.messageNames.runnableHealth:
+                                // Notify
+                                if (data.payload.status !== consts.healthStatuses.healthy) {
+                                    asticode.notifier.warning(data.from.worker + " runnable " + data.from.name + " is " + data.payload.status + (typeof data.payload.message !== "undefined" ? ": " + data.payload.message : ""))
+                                }
+                                break
+                            case consts.messageNames.runnableRestarting:
+                                // Notify
+                                asticode.notifier.warning(data.from.worker + " restarts " + data.from.name + " in " + data.payload.delay.toFixed(1) + "s (attempt " + data.payload.attempt + (data.payload.max_retries > 0 ? "/" + data.payload.max_retries : "") + ")")
+                                break
                         }
 
                         // Menu
@@ -1,7 +1,15 @@
 let consts = {
+    healthStatuses: {
+        degraded: "degraded",
+        healthy: "healthy",
+        unhealthy: "unhealthy",
+    },
     runnableStatuses: {
+        crashed: "crashed",
         running: "running",
+        starting: "starting",
         stopped: "stopped",
+        stopping: "stopping",
     },
     identifierTypes: {
         runnable: "runnable",
@@ -10,15 +18,23 @@ let consts = {
         worker: "worker",
     },
     messageNames: {
+        dispatcherOverflow: "dispatcher.overflow",
+        runnableConfigUpdated: "runnable.config.updated",
         runnableCrashed: "runnable.crashed",
+        runnableGaveUp: "runnable.gave.up",
+        runnableHealth: "runnable.health",
+        runnableRestarting: "runnable.restarting",
         runnableStart: "runnable.start",
         runnableStarted: "runnable.started",
+        runnableStarting: "runnable.starting",
         runnableStop: "runnable.stop",
         runnableStopped: "runnable.stopped",
+        runnableStopping: "runnable.stopping",
         uiPing: "ui.ping",
         uiRegister: "ui.register",
         uiWelcome: "ui.welcome",
         workerDisconnected: "worker.disconnected",
         workerRegistered: "worker.registered",
+        workerUpdated: "worker.updated",
     },
 }
\ No newline at end of file
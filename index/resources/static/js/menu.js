@@ -20,10 +20,16 @@ let menu = {
         switch (data.name) {
             case consts.messageNames.runnableCrashed:
             case consts.messageNames.runnableStarted:
+            case consts.messageNames.runnableStarting:
             case consts.messageNames.runnableStopped:
+            case consts.messageNames.runnableStopping:
                 // Update toggle
                 menu.updateToggle(data)
                 break
+            case consts.messageNames.runnableHealth:
+                // Update health
+                menu.updateHealth(data)
+                break
             case consts.messageNames.workerDisconnected:
                 // Remove worker from menu
                 menu.removeWorker(data.payload)
@@ -32,6 +38,10 @@ let menu = {
                 // Add worker to menu
                 menu.addWorker(data.payload)
                 break
+            case consts.messageNames.workerUpdated:
+                // Update worker in menu
+                menu.updateWorker(data.payload)
+                break
         }
     },
 
@@ -86,6 +96,37 @@ let menu = {
         }
         return r
     },
+    updateWorker: function(data) {
+        // Fetch worker
+        let worker = menu.workers[data.name]
+
+        // Worker doesn't exist
+        if (typeof worker === "undefined") {
+            menu.addWorker(data)
+            return
+        }
+
+        // Index runnables
+        let runnables = {}
+        if (typeof data.runnables !== "undefined") {
+            for (let k = 0; k < data.runnables.length; k++) {
+                runnables[data.runnables[k].name] = data.runnables[k]
+            }
+        }
+
+        // Remove runnables that have been unregistered
+        for (let name in worker.runnables) {
+            if (worker.runnables.hasOwnProperty(name) && typeof runnables[name] === "undefined") {
+                worker.runnables[name].html.wrapper.remove()
+                delete(worker.runnables[name])
+            }
+        }
+
+        // Add runnables that have been registered
+        for (let name in runnables) {
+            if (runnables.hasOwnProperty(name)) menu.addRunnable(worker, runnables[name])
+        }
+    },
     removeWorker: function(name) {
         // Fetch worker
         let worker = menu.workers[name]
@@ -153,6 +194,7 @@ let menu = {
         name.className = "cell"
         name.style.paddingRight = "10px"
         r.html.wrapper.appendChild(name)
+        r.html.name = name
 
         // Create title
         let title
@@ -166,6 +208,15 @@ let menu = {
         }
         name.appendChild(title)
 
+        // Create settings link
+        if (typeof data.config !== "undefined") {
+            let settings = document.createElement("a")
+            settings.className = "menu-runnable-settings"
+            settings.href = "/web/settings?worker=" + encodeURIComponent(r.worker_name) + "&runnable=" + encodeURIComponent(r.name)
+            settings.innerText = "settings"
+            name.appendChild(settings)
+        }
+
         // Create toggle cell
         let cell = document.createElement("div")
         cell.class = "cell"
@@ -188,7 +239,7 @@ let menu = {
             }
 
             // Add name
-            if (r.status === consts.runnableStatuses.stopped) {
+            if (r.status === consts.runnableStatuses.crashed || r.status === consts.runnableStatuses.stopped) {
                 m.name = consts.messageNames.runnableStart
             } else {
                 m.name = consts.messageNames.runnableStop
@@ -198,6 +249,9 @@ let menu = {
             base.sendWebsocketMessage(m)
         })
         cell.appendChild(r.html.toggle)
+
+        // Set health
+        menu.setHealth(r, data.health)
         return r
     },
     updateToggle: function(data) {
@@ -212,14 +266,42 @@ let menu = {
             // Runnable exists
             if (typeof runnable !== "undefined") {
                 // Update status
-                runnable.status = (data.name === consts.messageNames.runnableStarted ? consts.runnableStatuses.running : consts.runnableStatuses.stopped)
+                runnable.status = data.payload.status
 
                 // Update class
                 runnable.html.toggle.className = "toggle " + menu.toggleClass(runnable.status)
+
+                // Health is only checked while the runnable is running
+                if (runnable.status !== consts.runnableStatuses.running) menu.setHealth(runnable)
             }
         }
     },
+    updateHealth: function(data) {
+        // Fetch worker
+        let worker = menu.workers[data.from.worker]
+
+        // Worker exists
+        if (typeof worker !== "undefined") {
+            // Fetch runnable
+            let runnable = worker.runnables[data.from.name]
+
+            // Runnable exists
+            if (typeof runnable !== "undefined") menu.setHealth(runnable, data.payload)
+        }
+    },
+    setHealth: function(runnable, health) {
+        // Healthy
+        if (typeof health === "undefined" || health === null || health.status === consts.healthStatuses.healthy) {
+            runnable.html.name.className = "cell"
+            runnable.html.wrapper.title = runnable.description
+            return
+        }
+
+        // Degraded or unhealthy
+        runnable.html.name.className = "cell menu-runnable-" + health.status
+        runnable.html.wrapper.title = runnable.description + "\n" + health.status + (typeof health.message !== "undefined" ? ": " + health.message : "")
+    },
     toggleClass: function(status) {
-        return status === "running" ? "on" : "off"
+        return status === consts.runnableStatuses.running || status === consts.runnableStatuses.starting ? "on" : "off"
     }
 }
\ No newline at end of file
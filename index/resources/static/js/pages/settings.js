@@ -0,0 +1,192 @@
+let settings = {
+    inputs: {},
+    values: {},
+
+    init: function () {
+        base.init({
+            messageNames: [consts.messageNames.runnableConfigUpdated],
+            onLoad: settings.onLoad,
+            onMessage: settings.onMessage,
+        })
+    },
+    onLoad: function() {
+        // Get worker and runnable
+        let query = new URLSearchParams(window.location.search)
+        settings.worker = query.get("worker")
+        settings.runnable = query.get("runnable")
+
+        // Fetch
+        asticode.tools.sendHttp({
+            url: settings.url(),
+            method: "GET",
+            error: base.httpError,
+            success: function(data) {
+                // Render
+                settings.render(data.responseJSON)
+
+                // Finish
+                base.finish()
+            }
+        })
+    },
+    onMessage: function(data) {
+        switch (data.name) {
+            case consts.messageNames.runnableConfigUpdated:
+                // Config of another runnable
+                if (data.from.worker !== settings.worker || data.from.name !== settings.runnable) break
+
+                // Update values
+                settings.setValues(data.payload)
+                break
+        }
+    },
+    url: function() {
+        return "/api/workers/" + encodeURIComponent(settings.worker) + "/runnables/" + encodeURIComponent(settings.runnable) + "/config"
+    },
+    newCell: function(className) {
+        let cell = document.createElement("div")
+        cell.className = "cell" + (typeof className !== "undefined" ? " " + className : "")
+        return cell
+    },
+    render: function(data) {
+        // Get content
+        let content = document.querySelector("#content")
+
+        // Add header
+        let header = document.createElement("div")
+        header.className = "header"
+        header.innerText = settings.worker + " - " + settings.runnable + " - Settings"
+        content.appendChild(header)
+
+        // No properties
+        let keys = Object.keys(data.schema.properties || {}).sort()
+        if (keys.length === 0) {
+            content.appendChild(document.createTextNode("This runnable has no settings"))
+            return
+        }
+
+        // Create table
+        let table = document.createElement("div")
+        table.className = "table settings-table"
+        content.appendChild(table)
+
+        // Loop through properties
+        keys.forEach(function(k) {
+            // Get schema
+            let s = data.schema.properties[k]
+
+            // Create row
+            let row = document.createElement("div")
+            row.className = "row"
+            table.appendChild(row)
+
+            // Add title
+            let title = settings.newCell()
+            title.innerText = typeof s.title !== "undefined" ? s.title : k
+            row.appendChild(title)
+
+            // Add description
+            if (typeof s.description !== "undefined") {
+                let description = document.createElement("div")
+                description.className = "setting-description"
+                description.innerText = s.description
+                title.appendChild(description)
+            }
+
+            // Add input
+            let cell = settings.newCell()
+            row.appendChild(cell)
+            settings.inputs[k] = settings.newInput(k, s)
+            cell.appendChild(settings.inputs[k].html)
+        })
+
+        // Set values
+        settings.setValues(data.values)
+    },
+    newInput: function(key, schema) {
+        // Enum
+        if (typeof schema.enum !== "undefined") {
+            let i = {html: document.createElement("select")}
+            schema.enum.forEach(function(v, idx) {
+                let o = document.createElement("option")
+                o.value = idx
+                o.innerText = v
+                i.html.appendChild(o)
+            })
+            i.html.addEventListener("change", function() { settings.update(key, schema.enum[i.html.value]) })
+            i.set = function(v) { i.html.value = schema.enum.findIndex(e => JSON.stringify(e) === JSON.stringify(v)) }
+            return i
+        }
+
+        // Switch on type
+        let i = {}
+        switch (schema.type) {
+            case "boolean":
+                i.html = document.createElement("label")
+                i.html.innerHTML = '<span class="slider"></span>'
+                i.html.addEventListener("click", function() { settings.update(key, !i.value) })
+                i.set = function(v) {
+                    i.value = v === true
+                    i.html.className = "toggle " + (i.value ? "on" : "off")
+                }
+                break
+            case "integer":
+            case "number":
+                i.html = document.createElement("input")
+                i.html.type = "number"
+                if (typeof schema.minimum !== "undefined") i.html.min = schema.minimum
+                if (typeof schema.maximum !== "undefined") i.html.max = schema.maximum
+                if (schema.type === "number") i.html.step = "any"
+                i.html.addEventListener("change", function() { settings.update(key, Number(i.html.value)) })
+                i.set = function(v) { i.html.value = v }
+                break
+            case "string":
+                i.html = document.createElement("input")
+                i.html.type = "text"
+                i.html.addEventListener("change", function() { settings.update(key, i.html.value) })
+                i.set = function(v) { i.html.value = typeof v !== "undefined" && v !== null ? v : "" }
+                break
+            default:
+                // Arrays and objects are edited as JSON
+                i.html = document.createElement("input")
+                i.html.type = "text"
+                i.html.addEventListener("change", function() {
+                    try {
+                        settings.update(key, JSON.parse(i.html.value))
+                    } catch (e) {
+                        asticode.notifier.error(key + " is not valid JSON")
+                        i.set(settings.values[key])
+                    }
+                })
+                i.set = function(v) { i.html.value = JSON.stringify(v) }
+                break
+        }
+        return i
+    },
+    setValues: function(values) {
+        settings.values = values !== null ? values : {}
+        for (let k in settings.inputs) {
+            if (settings.inputs.hasOwnProperty(k)) settings.inputs[k].set(settings.values[k])
+        }
+    },
+    update: function(key, value) {
+        // Create payload
+        let payload = {}
+        payload[key] = value
+
+        // Send
+        asticode.tools.sendHttp({
+            url: settings.url(),
+            method: "PATCH",
+            payload: JSON.stringify(payload),
+            error: function(data) {
+                // Reset values
+                settings.setValues(settings.values)
+
+                // Default
+                base.httpError(data)
+            },
+            success: function(data) { settings.setValues(data.responseJSON) },
+        })
+    },
+};
@@ -0,0 +1,143 @@
+let traces = {
+    init: function () {
+        base.init({
+            onLoad: traces.onLoad,
+        })
+    },
+    onLoad: function() {
+        // Get trace id
+        let id = new URLSearchParams(window.location.search).get("id")
+
+        // Fetch
+        asticode.tools.sendHttp({
+            url: id !== null ? "/api/traces/" + encodeURIComponent(id) : "/api/traces",
+            method: "GET",
+            error: base.httpError,
+            success: function(data) {
+                // Render
+                if (id !== null) {
+                    traces.renderSpans(id, data.responseJSON)
+                } else {
+                    traces.renderTraces(data.responseJSON)
+                }
+
+                // Finish
+                base.finish()
+            }
+        })
+    },
+    formatDuration: function(us) {
+        if (us < 1000) return us + "µs"
+        if (us < 1000000) return (us / 1000).toFixed(2) + "ms"
+        return (us / 1000000).toFixed(2) + "s"
+    },
+    newHeader: function(text) {
+        let header = document.createElement("div")
+        header.className = "header"
+        header.innerText = text
+        return header
+    },
+    newCell: function(text, className) {
+        let cell = document.createElement("div")
+        cell.className = "cell" + (typeof className !== "undefined" ? " " + className : "")
+        cell.innerText = text
+        return cell
+    },
+    renderTraces: function(data) {
+        // Get content
+        let content = document.querySelector("#content")
+
+        // Add header
+        content.appendChild(traces.newHeader("Traces"))
+
+        // No traces
+        if (data === null || data.length === 0) {
+            content.appendChild(document.createTextNode("No traces have been recorded yet"))
+            return
+        }
+
+        // Create table
+        let table = document.createElement("div")
+        table.className = "table traces-table"
+        content.appendChild(table)
+
+        // Loop through traces
+        data.forEach(function(t) {
+            // Create row
+            let row = document.createElement("a")
+            row.className = "row"
+            row.href = "/web/traces?id=" + encodeURIComponent(t.trace_id)
+            table.appendChild(row)
+
+            // Add cells
+            row.appendChild(traces.newCell(new Date(t.timestamp / 1000).toLocaleString()))
+            row.appendChild(traces.newCell(t.name))
+            row.appendChild(traces.newCell(t.services.join(", ")))
+            row.appendChild(traces.newCell(t.spans + " span(s)"))
+            row.appendChild(traces.newCell(traces.formatDuration(t.duration), "trace-span-duration"))
+        })
+    },
+    renderSpans: function(id, data) {
+        // Get content
+        let content = document.querySelector("#content")
+
+        // Add header
+        content.appendChild(traces.newHeader("Trace " + id))
+
+        // No spans
+        if (data === null || data.length === 0) {
+            content.appendChild(document.createTextNode("No spans have been found for this trace"))
+            return
+        }
+
+        // Get boundaries
+        let start = data[0].timestamp
+        let end = start
+        data.forEach(function(s) { end = Math.max(end, s.timestamp + s.duration) })
+        let total = Math.max(end - start, 1)
+
+        // Index spans by id
+        let spans = {}
+        data.forEach(function(s) { spans[s.id] = s })
+
+        // Create table
+        let table = document.createElement("div")
+        table.className = "table traces-table"
+        content.appendChild(table)
+
+        // Loop through spans
+        data.forEach(function(s) {
+            // Get depth
+            let depth = 0
+            for (let p = spans[s.parentId]; typeof p !== "undefined" && depth < data.length; p = spans[p.parentId]) depth++
+
+            // Create row
+            let row = document.createElement("div")
+            row.className = "row"
+            table.appendChild(row)
+
+            // Add name
+            let name = traces.newCell(s.localEndpoint.serviceName + ": " + s.name, "trace-span-name")
+            name.style.paddingLeft = (10 + depth * 15) + "px"
+            row.appendChild(name)
+
+            // Add timeline
+            let timeline = traces.newCell("", "trace-span-timeline")
+            row.appendChild(timeline)
+            let bar = document.createElement("div")
+            bar.className = "trace-span-bar"
+            if (typeof s.tags !== "undefined" && typeof s.tags.error !== "undefined") asticode.tools.addClass(bar, "error")
+            bar.style.left = ((s.timestamp - start) / total * 100) + "%"
+            bar.style.width = (s.duration / total * 100) + "%"
+            timeline.appendChild(bar)
+
+            // Add tags
+            if (typeof s.tags !== "undefined") {
+                bar.title = Object.keys(s.tags).sort().map(k => k + ": " + s.tags[k]).join("\n")
+            }
+
+            // Add duration
+            row.appendChild(traces.newCell(traces.formatDuration(s.duration), "trace-span-duration"))
+        })
+    },
+};
@@ -17,6 +17,49 @@ import (
 )
 
 func (i *Index) updateRunnableStatus(m *astibob.Message) (err error) {
+	// Parse payload
+	var t astibob.RunnableTransition
+	if t, err = astibob.ParseRunnableTransitionPayload(m); err != nil {
+		err = errors.Wrap(err, "index: parsing transition payload failed")
+		return
+	}
+
+	// Update runnable
+	return i.updateRunnable(m, func(r *astibob.RunnableMessage) {
+		// Update status
+		r.Status = t.Status
+
+		// Health is only checked while the runnable is running
+		if t.Status != astibob.RunningStatus {
+			r.Health = nil
+		}
+
+		// Add transition
+		r.Transitions = append(r.Transitions, t)
+		if len(r.Transitions) > i.o.RunnableTransitions {
+			r.Transitions = r.Transitions[len(r.Transitions)-i.o.RunnableTransitions:]
+		}
+	})
+}
+
+func (i *Index) updateRunnableHealth(m *astibob.Message) (err error) {
+	// Parse payload
+	var h astibob.Health
+	if h, err = astibob.ParseRunnableHealthPayload(m); err != nil {
+		err = errors.Wrap(err, "index: parsing health payload failed")
+		return
+	}
+
+	// Update runnable
+	return i.updateRunnable(m, func(r *astibob.RunnableMessage) {
+		if r.Status == astibob.RunningStatus {
+			r.Health = &h
+		}
+	})
+}
+
+// Updates the runnable that has sent the message
+func (i *Index) updateRunnable(m *astibob.Message, fn func(r *astibob.RunnableMessage)) (err error) {
 	// Check worker
 	if m.From.Worker == nil {
 		err = errors.New("index: no from worker")
@@ -40,10 +83,12 @@ func (i *Index) updateRunnableStatus(m *astibob.Message) (err error) {
 		return
 	}
 
-	// Get runnable
+	// Lock
 	w.mr.Lock()
+	defer w.mr.Unlock()
+
+	// Get runnable
 	r, ok := w.rs[*m.From.Name]
-	w.mr.Unlock()
 
 	// No runnable
 	if !ok {
@@ -51,18 +96,65 @@ func (i *Index) updateRunnableStatus(m *astibob.Message) (err error) {
 		return
 	}
 
-	// Update status
-	if m.Name == astibob.RunnableStartedMessage {
-		r.Status = astibob.RunningStatus
-	} else {
-		r.Status = astibob.StoppedStatus
-	}
-
 	// Update runnable
+	fn(&r)
 	w.rs[*m.From.Name] = r
 	return
 }
 
+func (i *Index) apiWorkers(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	ws := i.workers()
+	if ws == nil {
+		ws = []astibob.Worker{}
+	}
+	astibob.WriteHTTPData(rw, ws)
+}
+
+func (i *Index) runnableTransitions(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Unescape worker
+	worker, err := url.QueryUnescape(p.ByName("worker"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping worker failed"))
+		return
+	}
+
+	// Unescape runnable
+	runnable, err := url.QueryUnescape(p.ByName("runnable"))
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusBadRequest, errors.Wrap(err, "index: unescaping runnable failed"))
+		return
+	}
+
+	// Get worker
+	i.mw.Lock()
+	w, ok := i.ws[worker]
+	i.mw.Unlock()
+
+	// No worker
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Get runnable
+	w.mr.Lock()
+	rm, ok := w.rs[runnable]
+	w.mr.Unlock()
+
+	// No runnable
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Write
+	ts := rm.Transitions
+	if ts == nil {
+		ts = []astibob.RunnableTransition{}
+	}
+	astibob.WriteHTTPData(rw, ts)
+}
+
 func (i *Index) sendRequestToRunnable(rw http.ResponseWriter, p httprouter.Params, method, path string, body io.Reader, header http.Header, fn func(worker, runnable, url string, resp *http.Response)) {
 	// Unescape worker
 	worker, err := url.QueryUnescape(p.ByName("worker"))
@@ -3,12 +3,18 @@ package index
 import (
 	"net/http"
 
+	"github.com/asticode/go-astibob"
 	astihttp "github.com/asticode/go-astitools/http"
 	"github.com/julienschmidt/httprouter"
 )
 
 // Serve spawns the server
 func (i *Index) Serve() {
+	i.w.Serve(i.o.Server.Addr, i.Handler())
+}
+
+// Handler returns the handler of the server
+func (i *Index) Handler() http.Handler {
 	// Create router
 	r := httprouter.New()
 
@@ -22,12 +28,31 @@ func (i *Index) Serve() {
 	r.GET("/web/*page", i.web)
 
 	// API
+	astibob.AddDeadLetterRoutes(r, i.d)
+	r.POST("/api/messages", i.handleAPIMessage)
+	r.GET("/api/health", i.health)
+	r.GET("/api/metrics", astibob.MetricsHandle(i.m))
 	r.GET("/api/ok", i.ok)
 	r.GET("/api/references", i.references)
+	r.GET("/api/traces", i.traces)
+	r.GET("/api/traces/:id", i.trace)
+	r.GET("/api/workers", i.apiWorkers)
+	r.GET("/api/workers/:worker/runnables/:runnable/config", i.runnableConfig)
+	r.PATCH("/api/workers/:worker/runnables/:runnable/config", i.updateRunnableConfigFromAPI)
+	r.POST("/api/workers/:worker/runnables/:runnable/requests", i.request)
+	r.GET("/api/workers/:worker/runnables/:runnable/transitions", i.runnableTransitions)
 
 	// Websockets
 	r.GET("/websockets/ui", i.handleUIWebsocket)
-	r.GET("/websockets/worker", i.handleWorkerWebsocket)
+
+	// Transport
+	if t, ok := i.tr.(astibob.RoutedTransport); ok {
+		for p, rs := range t.Routes() {
+			for m, h := range rs {
+				r.Handle(m, p, h)
+			}
+		}
+	}
 
 	// Runnable
 	for _, m := range []string{http.MethodDelete, http.MethodGet, http.MethodPatch, http.MethodPost} {
@@ -37,10 +62,7 @@ func (i *Index) Serve() {
 
 	// Chain middlewares
 	h := astihttp.ChainMiddlewares(r, astihttp.MiddlewareBasicAuth(i.o.Server.Username, i.o.Server.Password))
-	h = astihttp.ChainMiddlewaresWithPrefix(h, []string{"/api/"}, astihttp.MiddlewareContentType("application/json"))
-
-	// Serve
-	i.w.Serve(i.o.Server.Addr, h)
+	return astihttp.ChainMiddlewaresWithPrefix(h, []string{"/api/"}, astihttp.MiddlewareContentType("application/json"))
 }
 
 func (i *Index) ok(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {}
@@ -0,0 +1,145 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Traces returns the summaries of the traces recorded by the workers, most recent first
+func (i *Index) Traces(ctx context.Context) (ts []astibob.TraceSummary) {
+	// Loop through workers
+	ms := make(map[string]*astibob.TraceSummary)
+	for _, addr := range i.workerAddrs() {
+		// Fetch summaries
+		var wts []astibob.TraceSummary
+		if err := i.fetchWorkerTraces(ctx, addr+"/api/traces", &wts); err != nil {
+			astilog.Error(errors.Wrapf(err, "index: fetching traces of %s failed", addr))
+			continue
+		}
+
+		// Merge summaries
+		for _, wt := range wts {
+			// First summary
+			m, ok := ms[wt.TraceID]
+			if !ok {
+				v := wt
+				ms[wt.TraceID] = &v
+				continue
+			}
+
+			// Update summary
+			end := m.Timestamp + m.Duration
+			if e := wt.Timestamp + wt.Duration; e > end {
+				end = e
+			}
+			if wt.Timestamp < m.Timestamp {
+				m.Name = wt.Name
+				m.Timestamp = wt.Timestamp
+			}
+			m.Duration = end - m.Timestamp
+			m.Services = mergeServices(m.Services, wt.Services)
+			m.Spans += wt.Spans
+		}
+	}
+
+	// Loop through summaries
+	ts = []astibob.TraceSummary{}
+	for _, m := range ms {
+		ts = append(ts, *m)
+	}
+
+	// Sort
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Timestamp > ts[j].Timestamp })
+	return
+}
+
+func mergeServices(a, b []string) (ss []string) {
+	m := make(map[string]bool)
+	for _, s := range append(append([]string{}, a...), b...) {
+		if !m[s] {
+			m[s] = true
+			ss = append(ss, s)
+		}
+	}
+	sort.Strings(ss)
+	return
+}
+
+// Spans returns the spans of a trace recorded by the workers ordered by timestamp
+func (i *Index) Spans(ctx context.Context, traceID string) (ss []astibob.Span) {
+	// Loop through workers
+	ss = []astibob.Span{}
+	for _, addr := range i.workerAddrs() {
+		// Fetch spans
+		var wss []astibob.Span
+		if err := i.fetchWorkerTraces(ctx, addr+"/api/traces/"+traceID, &wss); err != nil {
+			astilog.Error(errors.Wrapf(err, "index: fetching spans of %s failed", addr))
+			continue
+		}
+
+		// Append
+		ss = append(ss, wss...)
+	}
+
+	// Sort
+	astibob.SortSpans(ss)
+	return
+}
+
+func (i *Index) workerAddrs() (as []string) {
+	// Lock
+	i.mw.Lock()
+	defer i.mw.Unlock()
+
+	// Loop through workers
+	for _, w := range i.ws {
+		as = append(as, w.addr)
+	}
+	return
+}
+
+func (i *Index) fetchWorkerTraces(ctx context.Context, u string, v interface{}) (err error) {
+	// Create request
+	var r *http.Request
+	if r, err = http.NewRequest(http.MethodGet, u, nil); err != nil {
+		err = errors.Wrapf(err, "index: creating GET request to %s failed", u)
+		return
+	}
+	r = r.WithContext(ctx)
+
+	// Send request
+	var resp *http.Response
+	if resp, err = i.c.Do(r); err != nil {
+		err = errors.Wrapf(err, "index: doing GET request to %s failed", u)
+		return
+	}
+	defer resp.Body.Close()
+
+	// Invalid status code
+	if resp.StatusCode != http.StatusOK {
+		err = errors.Errorf("index: invalid status code %d", resp.StatusCode)
+		return
+	}
+
+	// Unmarshal
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		err = errors.Wrap(err, "index: unmarshaling failed")
+		return
+	}
+	return
+}
+
+func (i *Index) traces(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, i.Traces(r.Context()))
+}
+
+func (i *Index) trace(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, i.Spans(r.Context(), p.ByName("id")))
+}
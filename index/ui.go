@@ -18,6 +18,16 @@ import (
 	"github.com/pkg/errors"
 )
 
+// UIs only understand JSON
+func uiCodec(name string) astibob.Codec {
+	return astibob.DefaultCodec()
+}
+
+// UIs only understand JSON which is sent in text frames
+func uiWriter(c *astiws.Client) messageWriter {
+	return func(_ string, p []byte) error { return c.WriteText(p) }
+}
+
 func uiName(c *astiws.Client) string {
 	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%p", c)))
 }
@@ -92,6 +102,9 @@ func (i *Index) handleUIMessage(p []byte) (err error) {
 		return
 	}
 
+	// Update metrics
+	i.m.MessageReceived("ui")
+
 	// Dispatch
 	i.d.Dispatch(m)
 	return
@@ -128,14 +141,49 @@ func (i *Index) sendMessageToUI(m *astibob.Message) (err error) {
 		i.mu.Unlock()
 	}
 
+	// Get writers
+	var ws map[string]messageWriter
+	if ws, err = i.uiWriters(names...); err != nil {
+		err = errors.Wrap(err, "index: getting ui writers failed")
+		return
+	}
+
 	// Send message
-	if err = sendMessage(m, "ui", i.wu, names...); err != nil {
+	if err = i.sendMessage(m, "ui", ws, uiCodec); err != nil {
 		err = errors.Wrap(err, "index: sending message failed")
 		return
 	}
 	return
 }
 
+func (i *Index) uiWriters(names ...string) (ws map[string]messageWriter, err error) {
+	// Get clients
+	ws = make(map[string]messageWriter)
+	if len(names) > 0 {
+		// Loop through names
+		for _, name := range names {
+			// Retrieve client from manager
+			c, ok := i.wu.Client(name)
+			if !ok {
+				err = fmt.Errorf("index: client %s doesn't exist", name)
+				return
+			}
+
+			// Add writer
+			ws[name] = uiWriter(c)
+		}
+	} else {
+		// Loop through clients
+		i.wu.Clients(func(k interface{}, c *astiws.Client) (err error) {
+			if name, ok := k.(string); ok {
+				ws[name] = uiWriter(c)
+			}
+			return
+		})
+	}
+	return
+}
+
 func (i *Index) registerUI(m *astibob.Message) (err error) {
 	// From name
 	if m.From.Name == nil {
@@ -1,36 +1,33 @@
 package index
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"sort"
 	"sync"
 
 	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
-	"github.com/asticode/go-astiws"
-	"github.com/gorilla/websocket"
-	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 )
 
 type worker struct {
 	addr string
+	c    astibob.Codec
+	cs   []string
 	mr   *sync.Mutex // Locks rs
 	name string
 	rs   map[string]astibob.RunnableMessage
-	ws   *astiws.Client
 }
 
-func newWorker(i astibob.Worker, ws *astiws.Client) (w *worker) {
+func newWorker(i astibob.Worker) (w *worker) {
 	// Create
 	w = &worker{
 		addr: i.Addr,
+		c:    astibob.NegotiateCodec(i.Codecs),
+		cs:   i.Codecs,
 		mr:   &sync.Mutex{},
 		name: i.Name,
 		rs:   make(map[string]astibob.RunnableMessage),
-		ws:   ws,
 	}
 
 	// Loop through runnables
@@ -47,8 +44,9 @@ func (w *worker) toMessage() (o astibob.Worker) {
 
 	// Create worker
 	o = astibob.Worker{
-		Addr: w.addr,
-		Name: w.name,
+		Addr:   w.addr,
+		Codecs: w.cs,
+		Name:   w.name,
 	}
 
 	// Get keys
@@ -68,39 +66,74 @@ func (w *worker) toMessage() (o astibob.Worker) {
 	return
 }
 
-func (i *Index) handleWorkerWebsocket(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
-	if err := i.ww.ServeHTTP(rw, r, func(c *astiws.Client) error {
-		c.SetMessageHandler(i.handleWorkerMessage(c))
-		return nil
-	}); err != nil {
-		if v, ok := errors.Cause(err).(*websocket.CloseError); !ok || v.Code != websocket.CloseNormalClosure {
-			astilog.Error(errors.Wrap(err, "index: handling worker websocket failed"))
-		}
+func (i *Index) handleWorkerMessage(c astibob.TransportConn, contentType string, p []byte) (err error) {
+	// Unmarshal
+	var m *astibob.Message
+	if m, err = astibob.UnmarshalMessage(contentType, p); err != nil {
+		err = errors.Wrap(err, "index: unmarshaling failed")
 		return
 	}
-}
 
-func (i *Index) handleWorkerMessage(c *astiws.Client) astiws.MessageHandler {
-	return func(p []byte) (err error) {
-		// Log
-		astilog.Debugf("index: handling worker message %s", p)
+	// Message has expired
+	if m.Expired() {
+		astilog.Debugf("index: dropping expired message %s from worker %s", m.Name, m.From.WorkerName())
+		i.m.MessageExpired(m.From.WorkerName())
+		return
+	}
 
-		// Unmarshal
-		m := astibob.NewMessage()
-		if err = json.Unmarshal(p, m); err != nil {
-			err = errors.Wrap(err, "index: unmarshaling failed")
-			return
-		}
+	// Log
+	astilog.Debugf("index: handling worker message %s", m.Name)
+
+	// Update metrics
+	i.m.MessageReceived(m.From.WorkerName())
+
+	// When the worker registers, we need to store the connection
+	if m.Name == astibob.WorkerRegisterMessage && m.From.Name != nil {
+		i.mw.Lock()
+		i.wcs[*m.From.Name] = c
+		i.mw.Unlock()
+	}
+
+	// Dispatch
+	i.d.Dispatch(m)
+	return
+}
 
-		// When the worker registers, we need to register the client
-		if m.Name == astibob.WorkerRegisterMessage && m.From.Name != nil {
-			i.ww.RegisterClient(*m.From.Name, c)
+func (i *Index) onWorkerClose(c astibob.TransportConn) {
+	// Remove connection
+	i.mw.Lock()
+	var name string
+	for n, wc := range i.wcs {
+		if wc == c {
+			name = n
+			delete(i.wcs, n)
+			break
 		}
+	}
+	_, registered := i.ws[name]
+	i.mw.Unlock()
 
-		// Dispatch
-		i.d.Dispatch(m)
+	// Worker was not registered
+	if name == "" || !registered {
+		return
+	}
+
+	// Create disconnected message
+	m, err := astibob.NewWorkerDisconnectedMessage(
+		*astibob.NewIndexIdentifier(),
+		&astibob.Identifier{Types: map[string]bool{
+			astibob.UIIdentifierType:     true,
+			astibob.WorkerIdentifierType: true,
+		}},
+		name,
+	)
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "index: creating disconnected message failed"))
 		return
 	}
+
+	// Dispatch
+	i.d.Dispatch(m)
 }
 
 func (i *Index) sendMessageToWorker(m *astibob.Message) (err error) {
@@ -122,14 +155,50 @@ func (i *Index) sendMessageToWorker(m *astibob.Message) (err error) {
 		names = append(names, worker)
 	}
 
+	// Get writers
+	var ws map[string]messageWriter
+	if ws, err = i.workerWriters(names...); err != nil {
+		err = errors.Wrap(err, "index: getting worker writers failed")
+		return
+	}
+
 	// Send message
-	if err = sendMessage(m, "worker", i.ww, names...); err != nil {
+	if err = i.sendMessage(m, "worker", ws, i.workerCodec); err != nil {
 		err = errors.Wrap(err, "index: sending message failed")
 		return
 	}
 	return
 }
 
+func (i *Index) workerWriters(names ...string) (ws map[string]messageWriter, err error) {
+	// Lock
+	i.mw.Lock()
+	defer i.mw.Unlock()
+
+	// Get connections
+	ws = make(map[string]messageWriter)
+	if len(names) > 0 {
+		// Loop through names
+		for _, name := range names {
+			// Retrieve connection
+			c, ok := i.wcs[name]
+			if !ok {
+				err = fmt.Errorf("index: connection %s doesn't exist", name)
+				return
+			}
+
+			// Add writer
+			ws[name] = c.Write
+		}
+	} else {
+		// Loop through connections
+		for name, c := range i.wcs {
+			ws[name] = c.Write
+		}
+	}
+	return
+}
+
 func (i *Index) addWorker(m *astibob.Message) (err error) {
 	// Parse payload
 	var mw astibob.Worker
@@ -138,41 +207,20 @@ func (i *Index) addWorker(m *astibob.Message) (err error) {
 		return
 	}
 
-	// Retrieve client
-	c, ok := i.ww.Client(mw.Name)
-	if !ok {
-		err = fmt.Errorf("index: client %s doesn't exist", mw.Name)
-		return
-	}
-
-	// Create worker
-	w := newWorker(mw, c)
-
 	// Update pool
 	i.mw.Lock()
-	i.ws[w.name] = w
+	_, ok := i.wcs[mw.Name]
+	w := newWorker(mw)
+	if ok {
+		i.ws[w.name] = w
+	}
 	i.mw.Unlock()
 
-	// Handle disconnect
-	c.SetListener(astiws.EventNameDisconnect, func(_ *astiws.Client, _ string, _ json.RawMessage) (err error) {
-		// Create disconnected message
-		var m *astibob.Message
-		if m, err = astibob.NewWorkerDisconnectedMessage(
-			*astibob.NewIndexIdentifier(),
-			&astibob.Identifier{Types: map[string]bool{
-				astibob.UIIdentifierType:     true,
-				astibob.WorkerIdentifierType: true,
-			}},
-			w.name,
-		); err != nil {
-			err = errors.Wrap(err, "astibob: creating disconnected message failed")
-			return
-		}
-
-		// Dispatch
-		i.d.Dispatch(m)
+	// Connection doesn't exist
+	if !ok {
+		err = fmt.Errorf("index: connection %s doesn't exist", mw.Name)
 		return
-	})
+	}
 
 	// Log
 	astilog.Infof("index: worker %s has registered", w.name)
@@ -182,6 +230,7 @@ func (i *Index) addWorker(m *astibob.Message) (err error) {
 		*astibob.NewIndexIdentifier(),
 		astibob.NewWorkerIdentifier(w.name),
 		astibob.WelcomeWorker{
+			Codec:          w.c.Name(),
 			UIMessageNames: i.uiMessageNames(),
 			Workers:        i.workers(),
 		},
@@ -211,6 +260,78 @@ func (i *Index) addWorker(m *astibob.Message) (err error) {
 	return
 }
 
+func (i *Index) updateWorker(m *astibob.Message) (err error) {
+	// Parse payload
+	var mw astibob.Worker
+	if mw, err = astibob.ParseWorkerUpdatePayload(m); err != nil {
+		err = errors.Wrap(err, "index: parsing payload failed")
+		return
+	}
+
+	// Only workers can update themselves
+	if m.From.WorkerName() != mw.Name {
+		err = fmt.Errorf("index: worker %s can't update worker %s", m.From.WorkerName(), mw.Name)
+		return
+	}
+
+	// Get worker
+	i.mw.Lock()
+	w, ok := i.ws[mw.Name]
+	i.mw.Unlock()
+
+	// No worker
+	if !ok {
+		err = fmt.Errorf("index: worker %s doesn't exist", mw.Name)
+		return
+	}
+
+	// Update runnables
+	w.mr.Lock()
+	rs := make(map[string]astibob.RunnableMessage)
+	for _, r := range mw.Runnables {
+		// Transitions of runnables that were already registered are kept
+		if p, ok := w.rs[r.Name]; ok {
+			r.Transitions = p.Transitions
+		}
+		rs[r.Name] = r
+	}
+	w.rs = rs
+	w.mr.Unlock()
+
+	// Log
+	astilog.Infof("index: worker %s has updated its runnables", w.name)
+
+	// Create updated message
+	if m, err = astibob.NewWorkerUpdatedMessage(
+		*astibob.NewIndexIdentifier(),
+		&astibob.Identifier{Types: map[string]bool{
+			astibob.UIIdentifierType:     true,
+			astibob.WorkerIdentifierType: true,
+		}},
+		w.toMessage(),
+	); err != nil {
+		err = errors.Wrap(err, "index: creating updated message failed")
+		return
+	}
+
+	// Dispatch
+	i.d.Dispatch(m)
+	return
+}
+
+func (i *Index) workerCodec(name string) astibob.Codec {
+	// Lock
+	i.mw.Lock()
+	defer i.mw.Unlock()
+
+	// Get worker
+	w, ok := i.ws[name]
+	if !ok {
+		return astibob.DefaultCodec()
+	}
+	return w.c
+}
+
 func (i *Index) delWorker(m *astibob.Message) (err error) {
 	// Parse payload
 	var name string
@@ -224,8 +345,11 @@ func (i *Index) delWorker(m *astibob.Message) (err error) {
 	delete(i.ws, name)
 	i.mw.Unlock()
 
-	// Unregister client
-	i.ww.UnregisterClient(name)
+	// Requests sent to the worker will never be done
+	if err = i.failPendingRequests(name); err != nil {
+		err = errors.Wrap(err, "index: failing pending requests failed")
+		return
+	}
 
 	// Log
 	astilog.Infof("index: worker %s has disconnected", name)
@@ -0,0 +1,267 @@
+package astibob
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// MemoryTransport is a transport for an index and workers running in the same process. The same transport must be
+// provided to all of them and no port is opened.
+type MemoryTransport struct {
+	c  *sync.Cond         // Its locker locks ih and ws
+	ih *TransportHandlers // Index handlers
+	ws map[string]TransportHandler
+}
+
+// NewMemoryTransport creates a new memory transport
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{
+		c:  sync.NewCond(&sync.Mutex{}),
+		ws: make(map[string]TransportHandler),
+	}
+}
+
+// DialIndex implements the Transport interface
+func (t *MemoryTransport) DialIndex(ctx context.Context, hs TransportHandlers) {
+	// Wake up when the context is done
+	go func() {
+		<-ctx.Done()
+		t.c.L.Lock()
+		t.c.Broadcast()
+		t.c.L.Unlock()
+	}()
+
+	// Loop
+	for {
+		// Wait for the index to listen
+		t.c.L.Lock()
+		for t.ih == nil && ctx.Err() == nil {
+			t.c.Wait()
+		}
+		ih := t.ih
+		t.c.L.Unlock()
+
+		// Context is done
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Create connections
+		wc, ic := newMemoryTransportConns(ctx, hs, *ih)
+
+		// Open
+		if err := ih.open(ic); err != nil {
+			wc.Close()
+		} else if err = hs.open(wc); err != nil {
+			wc.Close()
+		}
+
+		// Wait for the connections to be closed
+		<-wc.done
+
+		// Wait before reconnecting
+		sleepTransportRetry(ctx)
+	}
+}
+
+// ListenIndex implements the Transport interface
+func (t *MemoryTransport) ListenIndex(ctx context.Context, hs TransportHandlers) (err error) {
+	// Lock
+	t.c.L.Lock()
+	defer t.c.L.Unlock()
+
+	// Index is already listening
+	if t.ih != nil {
+		err = fmt.Errorf("astibob: index is already listening")
+		return
+	}
+
+	// Store handlers
+	t.ih = &hs
+	t.c.Broadcast()
+
+	// Stop listening when the context is done
+	go func() {
+		<-ctx.Done()
+		t.c.L.Lock()
+		t.ih = nil
+		t.c.L.Unlock()
+	}()
+	return
+}
+
+// ListenWorker implements the Transport interface
+func (t *MemoryTransport) ListenWorker(ctx context.Context, name string, h TransportHandler) (err error) {
+	// Lock
+	t.c.L.Lock()
+	defer t.c.L.Unlock()
+
+	// Worker is already listening
+	if _, ok := t.ws[name]; ok {
+		err = fmt.Errorf("astibob: worker %s is already listening", name)
+		return
+	}
+
+	// Store handler
+	t.ws[name] = h
+
+	// Stop listening when the context is done
+	go func() {
+		<-ctx.Done()
+		t.c.L.Lock()
+		delete(t.ws, name)
+		t.c.L.Unlock()
+	}()
+	return
+}
+
+// SendToWorker implements the Transport interface. Messages are handled synchronously.
+func (t *MemoryTransport) SendToWorker(ctx context.Context, name, addr, contentType string, p []byte) (err error) {
+	// Get handler
+	t.c.L.Lock()
+	h, ok := t.ws[name]
+	t.c.L.Unlock()
+
+	// No handler
+	if !ok {
+		err = fmt.Errorf("astibob: worker %s is not listening", name)
+		return
+	}
+
+	// Handle
+	return h(contentType, append([]byte{}, p...))
+}
+
+// Each side of a memory connection delivers the payloads it's written to the other side in order, from its own
+// goroutine, like a websocket would
+type memoryTransportConn struct {
+	c    *sync.Cond // Its locker locks ps
+	done chan struct{}
+	hs   TransportHandlers // Handlers of the other side
+	o    *memoryTransportConn
+	once *sync.Once
+	ps   []memoryTransportPayload
+}
+
+type memoryTransportPayload struct {
+	contentType string
+	p           []byte
+}
+
+func newMemoryTransportConns(ctx context.Context, whs, ihs TransportHandlers) (wc, ic *memoryTransportConn) {
+	// Create connections
+	done := make(chan struct{})
+	once := &sync.Once{}
+	wc = &memoryTransportConn{
+		c:    sync.NewCond(&sync.Mutex{}),
+		done: done,
+		hs:   ihs,
+		once: once,
+	}
+	ic = &memoryTransportConn{
+		c:    sync.NewCond(&sync.Mutex{}),
+		done: done,
+		hs:   whs,
+		once: once,
+	}
+	wc.o = ic
+	ic.o = wc
+
+	// Close connections when the context is done
+	go func() {
+		select {
+		case <-ctx.Done():
+			wc.Close()
+		case <-done:
+		}
+	}()
+
+	// Deliver
+	go wc.deliver()
+	go ic.deliver()
+	return
+}
+
+func (c *memoryTransportConn) deliver() {
+	for {
+		// Lock
+		c.c.L.Lock()
+
+		// Wait for payloads
+		for len(c.ps) == 0 && !c.closed() {
+			c.c.Wait()
+		}
+
+		// Connection is closed
+		if c.closed() {
+			c.c.L.Unlock()
+			return
+		}
+
+		// Get payload
+		p := c.ps[0]
+		c.ps = c.ps[1:]
+
+		// Unlock
+		c.c.L.Unlock()
+
+		// Handle
+		if err := c.hs.message(c.o, p.contentType, p.p); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: handling memory message failed"))
+		}
+	}
+}
+
+func (c *memoryTransportConn) closed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close closes both sides of the connection
+func (c *memoryTransportConn) Close() error {
+	c.once.Do(func() {
+		// Close
+		close(c.done)
+
+		// Signal
+		for _, v := range []*memoryTransportConn{c, c.o} {
+			v.c.L.Lock()
+			v.c.Broadcast()
+			v.c.L.Unlock()
+		}
+
+		// Callbacks
+		c.hs.close(c.o)
+		c.o.hs.close(c)
+	})
+	return nil
+}
+
+// Write delivers the payload to the other side
+func (c *memoryTransportConn) Write(contentType string, p []byte) (err error) {
+	// Lock
+	c.c.L.Lock()
+	defer c.c.L.Unlock()
+
+	// Connection is closed
+	if c.closed() {
+		err = fmt.Errorf("astibob: connection is closed")
+		return
+	}
+
+	// Append
+	c.ps = append(c.ps, memoryTransportPayload{
+		contentType: contentType,
+		p:           append([]byte{}, p...),
+	})
+	c.c.Broadcast()
+	return
+}
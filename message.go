@@ -2,6 +2,8 @@ package astibob
 
 import (
 	"encoding/json"
+	"path"
+	"time"
 
 	astiptr "github.com/asticode/go-astitools/ptr"
 	"github.com/pkg/errors"
@@ -17,37 +19,120 @@ const (
 
 // Message names
 const (
-	ListenablesRegisterMessage  = "listenables.register"
-	RunnableCrashedMessage      = "runnable.crashed"
-	RunnableDoneMessage         = "runnable.done"
-	RunnableStartMessage        = "runnable.start"
-	RunnableStartedMessage      = "runnable.started"
-	RunnableStopMessage         = "runnable.stop"
-	RunnableStoppedMessage      = "runnable.stopped"
-	UIDisconnectedMessage       = "ui.disconnected"
-	UIMessageNamesAddMessage    = "ui.message.names.add"
-	UIMessageNamesDeleteMessage = "ui.message.names.delete"
-	UIPingMessage               = "ui.ping"
-	UIRegisterMessage           = "ui.register"
-	UIWelcomeMessage            = "ui.welcome"
-	WorkerDisconnectedMessage   = "worker.disconnected"
-	WorkerRegisterMessage       = "worker.register"
-	WorkerRegisteredMessage     = "worker.registered"
-	WorkerWelcomeMessage        = "worker.welcome"
+	DispatcherOverflowMessage    = "dispatcher.overflow"
+	ListenablesRegisterMessage   = "listenables.register"
+	ListenablesUnregisterMessage = "listenables.unregister"
+	MessageAckMessage            = "message.ack"
+	RunnableConfigUpdateMessage  = "runnable.config.update"
+	RunnableConfigUpdatedMessage = "runnable.config.updated"
+	RunnableCrashedMessage       = "runnable.crashed"
+	RunnableDoneMessage          = "runnable.done"
+	RunnableGaveUpMessage        = "runnable.gave.up"
+	RunnableHealthMessage        = "runnable.health"
+	RunnableRestartingMessage    = "runnable.restarting"
+	RunnableStartMessage         = "runnable.start"
+	RunnableStartedMessage       = "runnable.started"
+	RunnableStartingMessage      = "runnable.starting"
+	RunnableStopMessage          = "runnable.stop"
+	RunnableStoppedMessage       = "runnable.stopped"
+	RunnableStoppingMessage      = "runnable.stopping"
+	UIDisconnectedMessage        = "ui.disconnected"
+	UIMessageNamesAddMessage     = "ui.message.names.add"
+	UIMessageNamesDeleteMessage  = "ui.message.names.delete"
+	UIPingMessage                = "ui.ping"
+	UIRegisterMessage            = "ui.register"
+	UIWelcomeMessage             = "ui.welcome"
+	WorkerDisconnectedMessage    = "worker.disconnected"
+	WorkerRegisterMessage        = "worker.register"
+	WorkerRegisteredMessage      = "worker.registered"
+	WorkerUpdateMessage          = "worker.update"
+	WorkerUpdatedMessage         = "worker.updated"
+	WorkerWelcomeMessage         = "worker.welcome"
+)
+
+// Delivery modes
+const (
+	AtLeastOnceDeliveryMode = "at_least_once"
+	AtMostOnceDeliveryMode  = "at_most_once"
+)
+
+// Error codes
+const (
+	DeadlineExceededErrorCode   = "deadline_exceeded"
+	InvalidConfigErrorCode      = "invalid_config"
+	WorkerDisconnectedErrorCode = "worker_disconnected"
 )
 
 type Message struct {
-	From    Identifier      `json:"from"`
-	ID      int             `json:"id,omitempty"`
-	Name    string          `json:"name"`
-	Payload json.RawMessage `json:"payload,omitempty"`
-	To      *Identifier     `json:"to,omitempty"`
+	Binary    []byte          `json:"binary,omitempty" msgpack:"binary,omitempty"`
+	Delivery  *Delivery       `json:"delivery,omitempty" msgpack:"delivery,omitempty"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty" msgpack:"expires_at,omitempty"` // Expired messages are dropped instead of being handled
+	From      Identifier      `json:"from" msgpack:"from"`
+	ID        int             `json:"id,omitempty" msgpack:"id,omitempty"`
+	Name      string          `json:"name" msgpack:"name"`
+	Payload   json.RawMessage `json:"payload,omitempty" msgpack:"payload,omitempty"`
+	To        *Identifier     `json:"to,omitempty" msgpack:"to,omitempty"`
+	Trace     *Trace          `json:"trace,omitempty" msgpack:"trace,omitempty"`
+	reply     json.RawMessage
+}
+
+// SetTTL makes the message expire once the duration has elapsed. Since the expiry is absolute, clocks of the index
+// and of the workers should be in sync.
+func (m *Message) SetTTL(d time.Duration) *Message {
+	t := time.Now().Add(d)
+	m.ExpiresAt = &t
+	return m
+}
+
+// Deadline returns when the message expires. ok is false when the message never expires.
+func (m *Message) Deadline() (t time.Time, ok bool) {
+	if m.ExpiresAt == nil {
+		return
+	}
+	return *m.ExpiresAt, true
+}
+
+// Expired returns whether the message has expired
+func (m *Message) Expired() bool {
+	return m.ExpiresAt != nil && !time.Now().Before(*m.ExpiresAt)
+}
+
+// Delivery describes how a message sent to another worker is delivered. By default messages are delivered at most
+// once. Messages delivered at least once are kept in the sender's outbox and retried until the other worker
+// acknowledges them once handled, and are handled only once by the other worker thanks to their key.
+type Delivery struct {
+	Key  string `json:"key,omitempty" msgpack:"key,omitempty"` // Generated by the worker when empty
+	Mode string `json:"mode" msgpack:"mode"`
+}
+
+// AtLeastOnce returns whether the message must be delivered at least once
+func (m *Message) AtLeastOnce() bool {
+	return m.Delivery != nil && m.Delivery.Mode == AtLeastOnceDeliveryMode
+}
+
+// SetAtLeastOnce makes sure the message is delivered at least once. The key is generated when empty.
+func (m *Message) SetAtLeastOnce(key string) *Message {
+	m.Delivery = &Delivery{
+		Key:  key,
+		Mode: AtLeastOnceDeliveryMode,
+	}
+	return m
+}
+
+// Reply sets the payload sent back to the sender of a message that has an ID
+func (m *Message) Reply(v interface{}) (err error) {
+	if m.reply, err = json.Marshal(v); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling reply failed")
+		return
+	}
+	return
 }
 
 func (m *Message) Clone() (o *Message) {
 	// Create message
 	o = &Message{
 		From: *m.From.Clone(),
+		ID:   m.ID,
 		Name: m.Name,
 	}
 
@@ -56,19 +141,40 @@ func (m *Message) Clone() (o *Message) {
 		o.To = m.To.Clone()
 	}
 
+	// Clone delivery
+	if m.Delivery != nil {
+		d := *m.Delivery
+		o.Delivery = &d
+	}
+
+	// Clone expiry
+	if m.ExpiresAt != nil {
+		t := *m.ExpiresAt
+		o.ExpiresAt = &t
+	}
+
+	// Clone trace
+	o.Trace = m.Trace.Clone()
+
 	// Clone payload
 	if len(m.Payload) > 0 {
 		o.Payload = make(json.RawMessage, len(m.Payload))
 		copy(o.Payload, m.Payload)
 	}
+
+	// Clone binary
+	if len(m.Binary) > 0 {
+		o.Binary = make([]byte, len(m.Binary))
+		copy(o.Binary, m.Binary)
+	}
 	return
 }
 
 type Identifier struct {
-	Name   *string         `json:"name,omitempty"`
-	Type   string          `json:"type,omitempty"`
-	Types  map[string]bool `json:"types,omitempty"`
-	Worker *string         `json:"worker,omitempty"`
+	Name   *string         `json:"name,omitempty" msgpack:"name,omitempty"`
+	Type   string          `json:"type,omitempty" msgpack:"type,omitempty"`
+	Types  map[string]bool `json:"types,omitempty" msgpack:"types,omitempty"`
+	Worker *string         `json:"worker,omitempty" msgpack:"worker,omitempty"`
 }
 
 func NewIndexIdentifier() *Identifier {
@@ -127,13 +233,28 @@ func (i *Identifier) match(id Identifier) bool {
 		return false
 	}
 
+	// Check name
+	if i.Name != nil && (id.Name == nil || !Match(*i.Name, *id.Name)) {
+		return false
+	}
+
 	// Check worker
-	if i.Worker != nil && (id.Worker == nil || *i.Worker != *id.Worker) {
+	if i.Worker != nil && (id.Worker == nil || !Match(*i.Worker, *id.Worker)) {
 		return false
 	}
 	return true
 }
 
+// Match checks whether a name matches a pattern. Patterns are either exact names or glob patterns as described in
+// path.Match such as "speech_to_text.*" or "kitchen-*".
+func Match(pattern, name string) bool {
+	if pattern == name {
+		return true
+	}
+	ok, err := path.Match(pattern, name)
+	return err == nil && ok
+}
+
 func strOrMapMatch(srcStr, dstStr *string, srcMap, dstMap map[string]bool) bool {
 	if srcMap != nil {
 		if dstMap != nil {
@@ -164,6 +285,18 @@ func strOrMapMatch(srcStr, dstStr *string, srcMap, dstMap map[string]bool) bool
 	return true
 }
 
+// String returns a human readable version of the identifier
+func (i Identifier) String() string {
+	s := i.Type
+	if i.Worker != nil {
+		s += "." + *i.Worker
+	}
+	if i.Name != nil {
+		s += "." + *i.Name
+	}
+	return s
+}
+
 func (i Identifier) WorkerName() string {
 	switch i.Type {
 	case RunnableIdentifierType:
@@ -189,20 +322,25 @@ type UI struct {
 }
 
 type WelcomeWorker struct {
+	Codec          string   `json:"codec,omitempty"`
 	UIMessageNames []string `json:"ui_message_names,omitempty"`
 	Workers        []Worker `json:"workers,omitempty"`
 }
 
 type Worker struct {
 	Addr      string            `json:"addr,omitempty"`
+	Codecs    []string          `json:"codecs,omitempty"`
 	Name      string            `json:"name"`
 	Runnables []RunnableMessage `json:"runnables,omitempty"`
 }
 
 type RunnableMessage struct {
 	Metadata
-	Status      string `json:"status"`
-	WebHomepage string `json:"web_homepage,omitempty"`
+	Config      *RunnableConfig      `json:"config,omitempty"` // Only set for runnables implementing Configurable
+	Health      *Health              `json:"health,omitempty"` // Only set for running runnables implementing HealthChecker
+	Status      string               `json:"status"`
+	Transitions []RunnableTransition `json:"transitions,omitempty"` // Oldest first
+	WebHomepage string               `json:"web_homepage,omitempty"`
 }
 
 type Metadata struct {
@@ -211,17 +349,53 @@ type Metadata struct {
 }
 
 type Error struct {
+	Code    string `json:"code,omitempty"`
 	Message string `json:"message"`
 }
 
+// NewError creates a structured error that is sent back as is to the sender of a message that has an ID
+func NewError(code, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return e.Code + ": " + e.Message
+	}
+	return e.Message
+}
+
 type Listenables struct {
-	Names    []string `json:"names"`
-	Runnable string   `json:"runnable"`
+	Filters  map[string][]string `json:"filters,omitempty"` // Filter expressions indexed by message name. A message is only sent if its payload matches one of the expressions of its name. Names without expressions are not filtered.
+	Names    []string            `json:"names"`
+	Runnable string              `json:"runnable"`
 }
 
 type RunnableDone struct {
-	ID      int  `json:"id"`
-	Success bool `json:"success"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      int             `json:"id"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	Success bool            `json:"success"`
+}
+
+// RunnableRestart describes a restart of a runnable that has stopped on its own
+type RunnableRestart struct {
+	Attempt    int     `json:"attempt"`         // Number of restarts within the window, including this one
+	Delay      float64 `json:"delay,omitempty"` // In seconds
+	Error      string  `json:"error,omitempty"` // Empty when the runnable has stopped without error
+	MaxRetries int     `json:"max_retries,omitempty"`
+}
+
+// RunnableTransition is a change of a runnable's status
+type RunnableTransition struct {
+	At     time.Time `json:"at"`
+	Error  string    `json:"error,omitempty"` // Only set when the runnable has crashed
+	From   string    `json:"from,omitempty"`
+	Stack  string    `json:"stack,omitempty"` // Only set when the runnable has panicked
+	Status string    `json:"status"`
 }
 
 func NewMessage() *Message {
@@ -236,6 +410,26 @@ func newMessage(from Identifier, to *Identifier, name string) *Message {
 	return m
 }
 
+func NewDispatcherOverflowMessage(from Identifier, to *Identifier, o DispatcherOverflow) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, DispatcherOverflowMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(o); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseDispatcherOverflowPayload(m *Message) (o DispatcherOverflow, err error) {
+	if err = json.Unmarshal(m.Payload, &o); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
 func NewListenablesRegisterMessage(from Identifier, to *Identifier, l Listenables) (m *Message, err error) {
 	// Create message
 	m = newMessage(from, to, ListenablesRegisterMessage)
@@ -256,6 +450,26 @@ func ParseListenablesRegisterPayload(m *Message) (l Listenables, err error) {
 	return
 }
 
+func NewListenablesUnregisterMessage(from Identifier, to *Identifier, l Listenables) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, ListenablesUnregisterMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(l); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseListenablesUnregisterPayload(m *Message) (l Listenables, err error) {
+	if err = json.Unmarshal(m.Payload, &l); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
 func ParseRunnableStartPayload(m *Message) (name string, err error) {
 	if err = json.Unmarshal(m.Payload, &name); err != nil {
 		err = errors.Wrap(err, "astibob: unmarshaling failed")
@@ -272,8 +486,42 @@ func ParseRunnableStopPayload(m *Message) (name string, err error) {
 	return
 }
 
-func NewRunnableCrashedMessage(from Identifier, to *Identifier) *Message {
-	return newMessage(from, to, RunnableCrashedMessage)
+func NewMessageAckMessage(from Identifier, to *Identifier, key string) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, MessageAckMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(key); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseMessageAckPayload(m *Message) (key string, err error) {
+	if err = json.Unmarshal(m.Payload, &key); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
+// NewRunnableConfigUpdateMessage creates a message updating the properties of the config of a runnable implementing
+// Configurable. Properties missing from the update are left untouched.
+func NewRunnableConfigUpdateMessage(from Identifier, to *Identifier, update json.RawMessage) *Message {
+	m := newMessage(from, to, RunnableConfigUpdateMessage)
+	m.Payload = update
+	return m
+}
+
+func NewRunnableConfigUpdatedMessage(from Identifier, to *Identifier, c json.RawMessage) *Message {
+	m := newMessage(from, to, RunnableConfigUpdatedMessage)
+	m.Payload = c
+	return m
+}
+
+func NewRunnableCrashedMessage(from Identifier, to *Identifier, t RunnableTransition) (*Message, error) {
+	return newRunnableTransitionMessage(from, to, RunnableCrashedMessage, t)
 }
 
 func NewRunnableDoneMessage(to *Identifier, d RunnableDone) (m *Message, err error) {
@@ -296,12 +544,102 @@ func ParseRunnableDonePayload(m *Message) (d RunnableDone, err error) {
 	return
 }
 
-func NewRunnableStartedMessage(from Identifier, to *Identifier) *Message {
-	return newMessage(from, to, RunnableStartedMessage)
+func NewRunnableGaveUpMessage(from Identifier, to *Identifier, r RunnableRestart) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, RunnableGaveUpMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(r); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseRunnableGaveUpPayload(m *Message) (r RunnableRestart, err error) {
+	if err = json.Unmarshal(m.Payload, &r); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
+func NewRunnableHealthMessage(from Identifier, to *Identifier, h Health) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, RunnableHealthMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(h); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseRunnableHealthPayload(m *Message) (h Health, err error) {
+	if err = json.Unmarshal(m.Payload, &h); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
+func NewRunnableRestartingMessage(from Identifier, to *Identifier, r RunnableRestart) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, RunnableRestartingMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(r); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseRunnableRestartingPayload(m *Message) (r RunnableRestart, err error) {
+	if err = json.Unmarshal(m.Payload, &r); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
+func NewRunnableStartedMessage(from Identifier, to *Identifier, t RunnableTransition) (*Message, error) {
+	return newRunnableTransitionMessage(from, to, RunnableStartedMessage, t)
+}
+
+func NewRunnableStartingMessage(from Identifier, to *Identifier, t RunnableTransition) (*Message, error) {
+	return newRunnableTransitionMessage(from, to, RunnableStartingMessage, t)
+}
+
+func NewRunnableStoppedMessage(from Identifier, to *Identifier, t RunnableTransition) (*Message, error) {
+	return newRunnableTransitionMessage(from, to, RunnableStoppedMessage, t)
+}
+
+func NewRunnableStoppingMessage(from Identifier, to *Identifier, t RunnableTransition) (*Message, error) {
+	return newRunnableTransitionMessage(from, to, RunnableStoppingMessage, t)
+}
+
+func newRunnableTransitionMessage(from Identifier, to *Identifier, name string, t RunnableTransition) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, name)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(t); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
 }
 
-func NewRunnableStoppedMessage(from Identifier, to *Identifier) *Message {
-	return newMessage(from, to, RunnableStoppedMessage)
+// ParseRunnableTransitionPayload parses the payload of the runnable.crashed, runnable.started, runnable.starting,
+// runnable.stopped and runnable.stopping messages
+func ParseRunnableTransitionPayload(m *Message) (t RunnableTransition, err error) {
+	if err = json.Unmarshal(m.Payload, &t); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
 }
 
 func NewUIDisconnectedMessage(from Identifier, to *Identifier, name string) (m *Message, err error) {
@@ -444,6 +782,47 @@ func ParseWorkerRegisteredPayload(m *Message) (w Worker, err error) {
 	return
 }
 
+// NewWorkerUpdateMessage creates a message sent by a worker whose runnables have changed since it has registered
+func NewWorkerUpdateMessage(from Identifier, to *Identifier, w Worker) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, WorkerUpdateMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(w); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseWorkerUpdatePayload(m *Message) (w Worker, err error) {
+	if err = json.Unmarshal(m.Payload, &w); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
+func NewWorkerUpdatedMessage(from Identifier, to *Identifier, w Worker) (m *Message, err error) {
+	// Create message
+	m = newMessage(from, to, WorkerUpdatedMessage)
+
+	// Marshal payload
+	if m.Payload, err = json.Marshal(w); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling payload failed")
+		return
+	}
+	return
+}
+
+func ParseWorkerUpdatedPayload(m *Message) (w Worker, err error) {
+	if err = json.Unmarshal(m.Payload, &w); err != nil {
+		err = errors.Wrap(err, "astibob: unmarshaling failed")
+		return
+	}
+	return
+}
+
 func NewWorkerWelcomeMessage(from Identifier, to *Identifier, w WelcomeWorker) (m *Message, err error) {
 	// Create message
 	m = newMessage(from, to, WorkerWelcomeMessage)
@@ -0,0 +1,506 @@
+package astibob
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Metric types
+const (
+	counterMetricType   = "counter"
+	gaugeMetricType     = "gauge"
+	histogramMetricType = "histogram"
+)
+
+const (
+	metricsContentType            = "text/plain; version=0.0.4"
+	metricsHistogramBucketLabel   = "le"
+	metricsHistogramBucketsSuffix = "_bucket"
+)
+
+// Metric names shared by the index and workers
+const (
+	DispatcherQueueDroppedMetric = "astibob_dispatcher_queue_dropped_total"
+	DispatcherQueueExpiredMetric = "astibob_dispatcher_queue_expired_total"
+	DispatcherQueueLengthMetric  = "astibob_dispatcher_queue_length"
+	HandlerDurationMetric        = "astibob_handler_duration_seconds"
+	HandlerErrorsMetric          = "astibob_handler_errors_total"
+	MessagesExpiredMetric        = "astibob_messages_expired_total"
+	MessagesReceivedMetric       = "astibob_messages_received_total"
+	MessagesSentMetric           = "astibob_messages_sent_total"
+	RunnableRunningMetric        = "astibob_runnable_running"
+	RunnableUptimeMetric         = "astibob_runnable_uptime_seconds"
+	WebsocketReconnectsMetric    = "astibob_websocket_reconnects_total"
+	WorkerDeliveryRetriesMetric  = "astibob_worker_delivery_retries_total"
+	WorkerOutboxExpiredMetric    = "astibob_worker_outbox_expired_total"
+	WorkerOutboxLengthMetric     = "astibob_worker_outbox_length"
+	WorkerRequestFailuresMetric  = "astibob_worker_request_failures_total"
+)
+
+// DefaultBuckets are the default histogram buckets, in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Metrics holds metrics and writes them in the Prometheus text exposition format. All methods can be called on a nil
+// *Metrics in which case they do nothing.
+type Metrics struct {
+	fs []func()
+	m  *sync.Mutex // Locks fs and ms
+	ms map[string]*metric
+}
+
+type metric struct {
+	bs   []float64 // Histogram buckets
+	help string
+	ls   []string // Label names
+	name string
+	t    string
+	vs   map[string]*metricValue // Indexed by label values
+}
+
+type metricValue struct {
+	bs    []uint64 // Histogram bucket counts
+	count uint64
+	lvs   []string // Label values
+	sum   float64
+	v     float64
+}
+
+// NewMetrics creates new metrics
+func NewMetrics() *Metrics {
+	return &Metrics{
+		m:  &sync.Mutex{},
+		ms: make(map[string]*metric),
+	}
+}
+
+// Collect adds a func executed before metrics are written, which is useful to update metrics whose values are tracked
+// elsewhere
+func (m *Metrics) Collect(f func()) {
+	// Nil
+	if m == nil {
+		return
+	}
+
+	// Lock
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	// Append
+	m.fs = append(m.fs, f)
+}
+
+func (m *Metrics) metric(name, help, t string, bs []float64, ls []string) (o *metric) {
+	// Lock
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	// Metric exists
+	var ok bool
+	if o, ok = m.ms[name]; ok {
+		return
+	}
+
+	// Create metric
+	o = &metric{
+		bs:   bs,
+		help: help,
+		ls:   ls,
+		name: name,
+		t:    t,
+		vs:   make(map[string]*metricValue),
+	}
+	m.ms[name] = o
+	return
+}
+
+// Assumes the mutex is held
+func (o *metric) value(lvs []string) (v *metricValue) {
+	// Value exists
+	k := strings.Join(lvs, "\xff")
+	var ok bool
+	if v, ok = o.vs[k]; ok {
+		return
+	}
+
+	// Create value
+	v = &metricValue{lvs: append([]string{}, lvs...)}
+	if o.t == histogramMetricType {
+		v.bs = make([]uint64, len(o.bs))
+	}
+	o.vs[k] = v
+	return
+}
+
+// Counter is a metric that only goes up
+type Counter struct {
+	m  *Metrics
+	mc *metric
+}
+
+// Counter returns the counter with the provided name, creating it if needed
+func (m *Metrics) Counter(name, help string, labels ...string) *Counter {
+	if m == nil {
+		return nil
+	}
+	return &Counter{
+		m:  m,
+		mc: m.metric(name, help, counterMetricType, nil, labels),
+	}
+}
+
+// Add adds delta to the value with the provided label values
+func (c *Counter) Add(delta float64, labelValues ...string) {
+	// Nil
+	if c == nil {
+		return
+	}
+
+	// Lock
+	c.m.m.Lock()
+	defer c.m.m.Unlock()
+
+	// Add
+	c.mc.value(labelValues).v += delta
+}
+
+// Set sets the value with the provided label values. It should only be used for counters tracked elsewhere.
+func (c *Counter) Set(v float64, labelValues ...string) {
+	// Nil
+	if c == nil {
+		return
+	}
+
+	// Lock
+	c.m.m.Lock()
+	defer c.m.m.Unlock()
+
+	// Set
+	c.mc.value(labelValues).v = v
+}
+
+// Gauge is a metric that can go up and down
+type Gauge struct {
+	m  *Metrics
+	mc *metric
+}
+
+// Gauge returns the gauge with the provided name, creating it if needed
+func (m *Metrics) Gauge(name, help string, labels ...string) *Gauge {
+	if m == nil {
+		return nil
+	}
+	return &Gauge{
+		m:  m,
+		mc: m.metric(name, help, gaugeMetricType, nil, labels),
+	}
+}
+
+// Add adds delta to the value with the provided label values
+func (g *Gauge) Add(delta float64, labelValues ...string) {
+	// Nil
+	if g == nil {
+		return
+	}
+
+	// Lock
+	g.m.m.Lock()
+	defer g.m.m.Unlock()
+
+	// Add
+	g.mc.value(labelValues).v += delta
+}
+
+// Reset removes all values
+func (g *Gauge) Reset() {
+	// Nil
+	if g == nil {
+		return
+	}
+
+	// Lock
+	g.m.m.Lock()
+	defer g.m.m.Unlock()
+
+	// Reset
+	g.mc.vs = make(map[string]*metricValue)
+}
+
+// Set sets the value with the provided label values
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	// Nil
+	if g == nil {
+		return
+	}
+
+	// Lock
+	g.m.m.Lock()
+	defer g.m.m.Unlock()
+
+	// Set
+	g.mc.value(labelValues).v = v
+}
+
+// Histogram is a metric that samples observations in buckets
+type Histogram struct {
+	m  *Metrics
+	mc *metric
+}
+
+// Histogram returns the histogram with the provided name, creating it with the provided buckets if needed. If no
+// buckets are provided, DefaultBuckets are used.
+func (m *Metrics) Histogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	// Nil
+	if m == nil {
+		return nil
+	}
+
+	// Default buckets
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	// Sort buckets
+	bs := append([]float64{}, buckets...)
+	sort.Float64s(bs)
+	return &Histogram{
+		m:  m,
+		mc: m.metric(name, help, histogramMetricType, bs, labels),
+	}
+}
+
+// Observe adds an observation to the value with the provided label values
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	// Nil
+	if h == nil {
+		return
+	}
+
+	// Lock
+	h.m.m.Lock()
+	defer h.m.m.Unlock()
+
+	// Get value
+	mv := h.mc.value(labelValues)
+
+	// Update buckets
+	for idx, b := range h.mc.bs {
+		if v <= b {
+			mv.bs[idx]++
+		}
+	}
+
+	// Update count and sum
+	mv.count++
+	mv.sum += v
+}
+
+// ObserveDuration adds the duration since start, in seconds, to the value with the provided label values
+func (h *Histogram) ObserveDuration(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+// Write writes metrics in the Prometheus text exposition format
+func (m *Metrics) Write(w io.Writer) (err error) {
+	// Nil
+	if m == nil {
+		return
+	}
+
+	// Collect
+	m.m.Lock()
+	fs := append([]func(){}, m.fs...)
+	m.m.Unlock()
+	for _, f := range fs {
+		f()
+	}
+
+	// Lock
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	// Get names
+	var ns []string
+	for n := range m.ms {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+
+	// Loop through metrics
+	bw := bufio.NewWriter(w)
+	for _, n := range ns {
+		// Get metric
+		o := m.ms[n]
+
+		// Write header
+		fmt.Fprintf(bw, "# HELP %s %s\n", o.name, escapeMetricHelp(o.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", o.name, o.t)
+
+		// Get keys
+		var ks []string
+		for k := range o.vs {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+
+		// Loop through values
+		for _, k := range ks {
+			// Get value
+			v := o.vs[k]
+
+			// Not a histogram
+			if o.t != histogramMetricType {
+				fmt.Fprintf(bw, "%s%s %s\n", o.name, metricLabels(o.ls, v.lvs, "", ""), formatMetricValue(v.v))
+				continue
+			}
+
+			// Loop through buckets
+			for idx, b := range o.bs {
+				fmt.Fprintf(bw, "%s%s%s %d\n", o.name, metricsHistogramBucketsSuffix, metricLabels(o.ls, v.lvs, metricsHistogramBucketLabel, formatMetricValue(b)), v.bs[idx])
+			}
+			fmt.Fprintf(bw, "%s%s%s %d\n", o.name, metricsHistogramBucketsSuffix, metricLabels(o.ls, v.lvs, metricsHistogramBucketLabel, "+Inf"), v.count)
+			fmt.Fprintf(bw, "%s_sum%s %s\n", o.name, metricLabels(o.ls, v.lvs, "", ""), formatMetricValue(v.sum))
+			fmt.Fprintf(bw, "%s_count%s %d\n", o.name, metricLabels(o.ls, v.lvs, "", ""), v.count)
+		}
+	}
+
+	// Flush
+	if err = bw.Flush(); err != nil {
+		err = errors.Wrap(err, "astibob: flushing failed")
+		return
+	}
+	return
+}
+
+func metricLabels(ls, lvs []string, extraName, extraValue string) string {
+	// Loop through labels
+	var ps []string
+	for idx, l := range ls {
+		var v string
+		if idx < len(lvs) {
+			v = lvs[idx]
+		}
+		ps = append(ps, fmt.Sprintf(`%s="%s"`, l, escapeMetricLabelValue(v)))
+	}
+
+	// Add extra label
+	if extraName != "" {
+		ps = append(ps, fmt.Sprintf(`%s="%s"`, extraName, extraValue))
+	}
+
+	// No labels
+	if len(ps) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(ps, ",") + "}"
+}
+
+func escapeMetricHelp(i string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(i)
+}
+
+func escapeMetricLabelValue(i string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(i)
+}
+
+func formatMetricValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// MetricsHandle returns a handle writing the metrics in the Prometheus text exposition format
+func MetricsHandle(m *Metrics) httprouter.Handle {
+	return func(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+		// Set content type
+		rw.Header().Set("Content-Type", metricsContentType)
+
+		// Write
+		if err := m.Write(rw); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: writing metrics failed"))
+			return
+		}
+	}
+}
+
+// MetricsMiddleware returns a middleware measuring handlers duration and errors per message name
+func MetricsMiddleware(m *Metrics) Middleware {
+	// Create metrics
+	d := m.Histogram(HandlerDurationMetric, "Duration of message handlers in seconds", nil, "name")
+	e := m.Counter(HandlerErrorsMetric, "Number of message handlers that returned an error or panicked", "name")
+	return func(next MessageHandler) MessageHandler {
+		return func(msg *Message) (err error) {
+			// Measure
+			start := time.Now()
+			defer func() {
+				// Observe duration
+				d.ObserveDuration(start, msg.Name)
+
+				// Panic
+				if v := recover(); v != nil {
+					e.Add(1, msg.Name)
+					panic(v)
+				}
+
+				// Error
+				if err != nil {
+					e.Add(1, msg.Name)
+				}
+			}()
+
+			// Next
+			err = next(msg)
+			return
+		}
+	}
+}
+
+// CollectDispatcherMetrics makes sure the metrics are updated with the dispatcher queues stats before being written
+func CollectDispatcherMetrics(m *Metrics, d *Dispatcher) {
+	// Create metrics
+	dm := m.Counter(DispatcherQueueDroppedMetric, "Number of messages dropped by dispatcher queues", "key")
+	em := m.Counter(DispatcherQueueExpiredMetric, "Number of expired messages dropped by dispatcher queues", "key")
+	lm := m.Gauge(DispatcherQueueLengthMetric, "Number of messages waiting in dispatcher queues", "key")
+
+	// Collect
+	m.Collect(func() {
+		lm.Reset()
+		for _, s := range d.Stats() {
+			dm.Set(float64(s.Dropped), s.Key)
+			em.Set(float64(s.Expired), s.Key)
+			lm.Set(float64(s.Length), s.Key)
+		}
+	})
+}
+
+// MessageExpired increments the number of expired messages received from or about to be sent to a peer
+func (m *Metrics) MessageExpired(peer string) {
+	m.Counter(MessagesExpiredMetric, "Number of expired messages dropped when received from or sent to a peer", "peer").Add(1, peer)
+}
+
+// MessageReceived increments the number of messages received from a peer
+func (m *Metrics) MessageReceived(peer string) {
+	m.Counter(MessagesReceivedMetric, "Number of messages received per peer", "peer").Add(1, peer)
+}
+
+// MessageSent increments the number of messages sent to a peer
+func (m *Metrics) MessageSent(peer string) {
+	m.Counter(MessagesSentMetric, "Number of messages sent per peer", "peer").Add(1, peer)
+}
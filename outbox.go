@@ -0,0 +1,406 @@
+package astibob
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Default outbox options
+const (
+	defaultOutboxMaxEntries     = 1000
+	defaultOutboxMaxRetryPeriod = time.Minute
+	defaultOutboxMinRetryPeriod = time.Second
+)
+
+// OutboxOptions are the options of an outbox
+type OutboxOptions struct {
+	Dir            string        `toml:"dir"`              // If set, messages are stored in this directory and survive restarts
+	MaxEntries     int           `toml:"max_entries"`      // Maximum number of entries per worker, defaults to 1000
+	MaxRetryPeriod time.Duration `toml:"max_retry_period"` // Defaults to 1m
+	MinRetryPeriod time.Duration `toml:"min_retry_period"` // Period before the first retry, doubled after each attempt, defaults to 1s
+}
+
+// OutboxEntry is a message waiting to be acknowledged by a worker
+type OutboxEntry struct {
+	Attempts    int       `json:"attempts"`
+	CreatedAt   time.Time `json:"created_at"`
+	Message     *Message  `json:"message"`
+	NextAttempt time.Time `json:"next_attempt"`
+	Worker      string    `json:"worker"`
+}
+
+// Outbox keeps messages sent with the at-least-once delivery mode until they're acknowledged. When a directory is
+// provided, each entry is stored in a file located in a sub-directory named after the destination worker.
+type Outbox struct {
+	es map[string]map[string]*OutboxEntry // Entries indexed by worker --> key
+	m  *sync.Mutex                        // Locks es
+	o  OutboxOptions
+}
+
+// NewOutbox creates a new outbox and loads the entries stored in its directory
+func NewOutbox(o OutboxOptions) (b *Outbox, err error) {
+	// Create outbox
+	b = &Outbox{
+		es: make(map[string]map[string]*OutboxEntry),
+		m:  &sync.Mutex{},
+		o:  o,
+	}
+
+	// Default options
+	if b.o.MaxEntries <= 0 {
+		b.o.MaxEntries = defaultOutboxMaxEntries
+	}
+	if b.o.MaxRetryPeriod <= 0 {
+		b.o.MaxRetryPeriod = defaultOutboxMaxRetryPeriod
+	}
+	if b.o.MinRetryPeriod <= 0 {
+		b.o.MinRetryPeriod = defaultOutboxMinRetryPeriod
+	}
+
+	// Load
+	if err = b.load(); err != nil {
+		err = errors.Wrap(err, "astibob: loading outbox failed")
+		return
+	}
+	return
+}
+
+func (b *Outbox) load() (err error) {
+	// No dir
+	if b.o.Dir == "" {
+		return
+	}
+
+	// Create dir
+	if err = os.MkdirAll(b.o.Dir, 0755); err != nil {
+		err = errors.Wrapf(err, "astibob: mkdirall %s failed", b.o.Dir)
+		return
+	}
+
+	// Loop through files
+	var ps []string
+	if ps, err = filepath.Glob(filepath.Join(b.o.Dir, "*", "*.json")); err != nil {
+		err = errors.Wrap(err, "astibob: globbing failed")
+		return
+	}
+	for _, p := range ps {
+		// Read
+		var c []byte
+		if c, err = ioutil.ReadFile(p); err != nil {
+			err = errors.Wrapf(err, "astibob: reading %s failed", p)
+			return
+		}
+
+		// Unmarshal
+		var e OutboxEntry
+		if err = json.Unmarshal(c, &e); err != nil {
+			err = errors.Wrapf(err, "astibob: unmarshaling %s failed", p)
+			return
+		}
+
+		// Invalid entry
+		if e.Message == nil || e.Message.Delivery == nil {
+			continue
+		}
+
+		// Entries are retried as soon as possible after a restart
+		e.NextAttempt = time.Time{}
+
+		// Add entry
+		if _, ok := b.es[e.Worker]; !ok {
+			b.es[e.Worker] = make(map[string]*OutboxEntry)
+		}
+		b.es[e.Worker][e.Message.Delivery.Key] = &e
+	}
+	return
+}
+
+func (b *Outbox) path(worker, key string) string {
+	return filepath.Join(b.o.Dir, url.PathEscape(worker), url.PathEscape(key)+".json")
+}
+
+// Assumes the mutex is held
+func (b *Outbox) write(e *OutboxEntry) (err error) {
+	// No dir
+	if b.o.Dir == "" {
+		return
+	}
+
+	// Marshal
+	var c []byte
+	if c, err = json.Marshal(e); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling failed")
+		return
+	}
+
+	// Create dir
+	p := b.path(e.Worker, e.Message.Delivery.Key)
+	if err = os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		err = errors.Wrapf(err, "astibob: mkdirall %s failed", filepath.Dir(p))
+		return
+	}
+
+	// Write to a temporary file first so that a crash doesn't leave a partial entry behind
+	if err = ioutil.WriteFile(p+".tmp", c, 0644); err != nil {
+		err = errors.Wrapf(err, "astibob: writing %s failed", p+".tmp")
+		return
+	}
+
+	// Rename
+	if err = os.Rename(p+".tmp", p); err != nil {
+		err = errors.Wrapf(err, "astibob: renaming %s failed", p+".tmp")
+		return
+	}
+	return
+}
+
+// Add adds a message sent to a worker. The message must have a delivery key. An error is returned when the worker
+// already has the maximum number of entries waiting to be acknowledged.
+func (b *Outbox) Add(worker string, m *Message) (err error) {
+	// No key
+	if m.Delivery == nil || m.Delivery.Key == "" {
+		err = fmt.Errorf("astibob: message %s has no delivery key", m.Name)
+		return
+	}
+
+	// Lock
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	// Outbox is full
+	if _, ok := b.es[worker][m.Delivery.Key]; !ok && len(b.es[worker]) >= b.o.MaxEntries {
+		err = fmt.Errorf("astibob: outbox of worker %s is full", worker)
+		return
+	}
+
+	// Create entry
+	n := time.Now()
+	e := &OutboxEntry{
+		Attempts:    1,
+		CreatedAt:   n,
+		Message:     m,
+		NextAttempt: n.Add(b.o.MinRetryPeriod),
+		Worker:      worker,
+	}
+
+	// Write
+	if err = b.write(e); err != nil {
+		err = errors.Wrap(err, "astibob: writing entry failed")
+		return
+	}
+
+	// Add entry
+	if _, ok := b.es[worker]; !ok {
+		b.es[worker] = make(map[string]*OutboxEntry)
+	}
+	b.es[worker][m.Delivery.Key] = e
+	return
+}
+
+// Ack removes the message acknowledged by a worker
+func (b *Outbox) Ack(worker, key string) (err error) {
+	// Lock
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	// Entry doesn't exist
+	if _, ok := b.es[worker][key]; !ok {
+		return
+	}
+
+	// Delete
+	if err = b.del(worker, key); err != nil {
+		err = errors.Wrap(err, "astibob: deleting entry failed")
+		return
+	}
+	return
+}
+
+// Assumes the mutex is held
+func (b *Outbox) del(worker, key string) (err error) {
+	// Delete entry
+	delete(b.es[worker], key)
+	if len(b.es[worker]) == 0 {
+		delete(b.es, worker)
+	}
+
+	// Remove file
+	if b.o.Dir != "" {
+		if err = os.Remove(b.path(worker, key)); err != nil && !os.IsNotExist(err) {
+			err = errors.Wrapf(err, "astibob: removing %s failed", b.path(worker, key))
+			return
+		}
+		err = nil
+	}
+	return
+}
+
+// Due returns the entries whose next attempt is due, oldest first, and schedules their next attempt with an
+// exponential backoff. Entries whose message has expired are removed and returned separately.
+func (b *Outbox) Due(now time.Time) (es, expired []OutboxEntry) {
+	// Lock
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	// Loop through entries
+	for w, ws := range b.es {
+		for k, e := range ws {
+			// Message has expired
+			if e.Message.Expired() {
+				expired = append(expired, *e)
+				if err := b.del(w, k); err != nil {
+					astilog.Error(errors.Wrap(err, "astibob: deleting expired entry failed"))
+				}
+				continue
+			}
+
+			// Not due yet
+			if e.NextAttempt.After(now) {
+				continue
+			}
+
+			// Schedule next attempt
+			e.Attempts++
+			e.NextAttempt = now.Add(b.retryPeriod(e.Attempts))
+
+			// Append
+			es = append(es, *e)
+		}
+	}
+
+	// Sort
+	sort.Slice(es, func(i, j int) bool { return es[i].CreatedAt.Before(es[j].CreatedAt) })
+	return
+}
+
+func (b *Outbox) retryPeriod(attempts int) (d time.Duration) {
+	d = b.o.MinRetryPeriod
+	for idx := 1; idx < attempts && d < b.o.MaxRetryPeriod; idx++ {
+		d *= 2
+	}
+	if d > b.o.MaxRetryPeriod {
+		d = b.o.MaxRetryPeriod
+	}
+	return
+}
+
+// Retry schedules the next attempt of the entries of a worker as soon as possible, for instance when it has just
+// registered
+func (b *Outbox) Retry(worker string) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	for _, e := range b.es[worker] {
+		e.NextAttempt = time.Time{}
+	}
+}
+
+// Entries returns the entries waiting to be acknowledged, oldest first
+func (b *Outbox) Entries() (es []OutboxEntry) {
+	// Lock
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	// Loop through entries
+	es = []OutboxEntry{}
+	for _, ws := range b.es {
+		for _, e := range ws {
+			es = append(es, *e)
+		}
+	}
+
+	// Sort
+	sort.Slice(es, func(i, j int) bool { return es[i].CreatedAt.Before(es[j].CreatedAt) })
+	return
+}
+
+// Number of keys remembered by a deduplicator when no capacity is provided
+const defaultDeduplicatorCapacity = 10000
+
+// Deduplicator remembers the most recent delivery keys that have been handled as well as the keys being handled
+type Deduplicator struct {
+	c  int
+	hs map[string]bool // Keys being handled
+	ks map[string]bool // Keys that have been handled
+	m  *sync.Mutex     // Locks hs, ks and q
+	q  []string
+}
+
+// NewDeduplicator creates a new deduplicator remembering up to c keys
+func NewDeduplicator(c int) *Deduplicator {
+	if c <= 0 {
+		c = defaultDeduplicatorCapacity
+	}
+	return &Deduplicator{
+		c:  c,
+		hs: make(map[string]bool),
+		ks: make(map[string]bool),
+		m:  &sync.Mutex{},
+	}
+}
+
+func deduplicatorKey(keys []string) string {
+	return strings.Join(keys, "/")
+}
+
+// Handled returns whether the key has already been handled
+func (d *Deduplicator) Handled(keys ...string) bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.ks[deduplicatorKey(keys)]
+}
+
+// Handle returns whether the key should be handled, which is the case when it's neither handled nor being handled.
+// If so, Done must be called once it has been handled.
+func (d *Deduplicator) Handle(keys ...string) bool {
+	// Lock
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	// Key has already been handled or is being handled
+	k := deduplicatorKey(keys)
+	if d.ks[k] || d.hs[k] {
+		return false
+	}
+
+	// Key is being handled
+	d.hs[k] = true
+	return true
+}
+
+// Done remembers the key if it has been handled successfully. Otherwise it's forgotten so that it's handled again
+// when the message is retried.
+func (d *Deduplicator) Done(err error, keys ...string) {
+	// Lock
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	// Key is not being handled anymore
+	k := deduplicatorKey(keys)
+	delete(d.hs, k)
+
+	// Key has not been handled successfully
+	if err != nil || d.ks[k] {
+		return
+	}
+
+	// Remember key
+	d.ks[k] = true
+	d.q = append(d.q, k)
+
+	// Oldest keys are forgotten first
+	if len(d.q) > d.c {
+		delete(d.ks, d.q[0])
+		d.q = d.q[1:]
+	}
+}
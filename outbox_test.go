@@ -0,0 +1,169 @@
+package astibob
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testOutboxMessage(key string) *Message {
+	m := NewMessage()
+	m.Name = "test"
+	m.SetAtLeastOnce(key)
+	return m
+}
+
+func TestOutboxPersistence(t *testing.T) {
+	// Create dir
+	dir, err := ioutil.TempDir("", "astibob-outbox-")
+	if err != nil {
+		t.Fatalf("creating dir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Add
+	b, err := NewOutbox(OutboxOptions{Dir: dir})
+	if err != nil {
+		t.Fatalf("creating outbox failed: %v", err)
+	}
+	for _, k := range []string{"k1", "k2"} {
+		if err = b.Add("worker", testOutboxMessage(k)); err != nil {
+			t.Fatalf("adding %s failed: %v", k, err)
+		}
+	}
+
+	// Ack
+	if err = b.Ack("worker", "k1"); err != nil {
+		t.Fatalf("acking failed: %v", err)
+	}
+	if _, err = os.Stat(b.path("worker", "k1")); !os.IsNotExist(err) {
+		t.Errorf("expected file of acked entry to be removed, got %v", err)
+	}
+
+	// Reopen
+	if b, err = NewOutbox(OutboxOptions{Dir: dir}); err != nil {
+		t.Fatalf("reopening outbox failed: %v", err)
+	}
+	es := b.Entries()
+	if len(es) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(es))
+	}
+	if k := es[0].Message.Delivery.Key; k != "k2" {
+		t.Errorf("expected key k2, got %s", k)
+	}
+
+	// Loaded entries are due right away
+	if es, _ = b.Due(time.Now()); len(es) != 1 {
+		t.Errorf("expected 1 due entry, got %d", len(es))
+	}
+
+	// No temporary file is left behind
+	ps, _ := filepath.Glob(filepath.Join(dir, "*", "*.tmp"))
+	if len(ps) > 0 {
+		t.Errorf("expected no temporary file, got %v", ps)
+	}
+}
+
+func TestOutboxMaxEntries(t *testing.T) {
+	// Fill
+	b, _ := NewOutbox(OutboxOptions{MaxEntries: 2})
+	for _, k := range []string{"k1", "k2"} {
+		if err := b.Add("worker", testOutboxMessage(k)); err != nil {
+			t.Fatalf("adding %s failed: %v", k, err)
+		}
+	}
+
+	// Full
+	if err := b.Add("worker", testOutboxMessage("k3")); err == nil {
+		t.Error("expected error when outbox is full")
+	}
+
+	// Existing key is replaced
+	if err := b.Add("worker", testOutboxMessage("k2")); err != nil {
+		t.Errorf("replacing k2 failed: %v", err)
+	}
+
+	// Other workers are not impacted
+	if err := b.Add("other", testOutboxMessage("k3")); err != nil {
+		t.Errorf("adding to other worker failed: %v", err)
+	}
+}
+
+func TestOutboxDue(t *testing.T) {
+	// Add
+	b, _ := NewOutbox(OutboxOptions{MaxRetryPeriod: 4 * time.Second, MinRetryPeriod: time.Second})
+	n := time.Now()
+	b.Add("worker", testOutboxMessage("k1"))
+	b.Add("worker", testOutboxMessage("k2").SetTTL(-time.Second))
+
+	// Not due yet
+	es, ees := b.Due(n)
+	if len(es) != 0 {
+		t.Errorf("expected no due entry, got %d", len(es))
+	}
+	if len(ees) != 1 || ees[0].Message.Delivery.Key != "k2" {
+		t.Errorf("expected k2 to have expired, got %+v", ees)
+	}
+
+	// Backoff
+	for _, d := range []time.Duration{2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		if es, _ = b.Due(n.Add(time.Hour)); len(es) != 1 {
+			t.Fatalf("expected 1 due entry, got %d", len(es))
+		}
+		if p := es[0].NextAttempt.Sub(n.Add(time.Hour)); p != d {
+			t.Errorf("expected retry period %s, got %s", d, p)
+		}
+		n = es[0].NextAttempt.Add(-time.Hour)
+	}
+
+	// Retry
+	b.Retry("worker")
+	if es, _ = b.Due(time.Now()); len(es) != 1 {
+		t.Errorf("expected 1 due entry after retry, got %d", len(es))
+	}
+}
+
+func TestDeduplicator(t *testing.T) {
+	d := NewDeduplicator(1)
+
+	// Handle
+	if !d.Handle("worker", "k1") {
+		t.Fatal("expected k1 to be handled")
+	}
+	if d.Handle("worker", "k1") {
+		t.Error("expected k1 not to be handled while it's being handled")
+	}
+
+	// Failure
+	d.Done(errors.New("test"), "worker", "k1")
+	if d.Handled("worker", "k1") {
+		t.Error("expected k1 not to be handled after a failure")
+	}
+	if !d.Handle("worker", "k1") {
+		t.Fatal("expected k1 to be handled again after a failure")
+	}
+
+	// Success
+	d.Done(nil, "worker", "k1")
+	if !d.Handled("worker", "k1") {
+		t.Error("expected k1 to be handled")
+	}
+	if d.Handle("worker", "k1") {
+		t.Error("expected k1 not to be handled twice")
+	}
+
+	// Keys are namespaced
+	if d.Handled("other", "k1") {
+		t.Error("expected k1 of other worker not to be handled")
+	}
+
+	// Oldest keys are forgotten
+	d.Handle("worker", "k2")
+	d.Done(nil, "worker", "k2")
+	if d.Handled("worker", "k1") {
+		t.Error("expected k1 to be forgotten")
+	}
+}
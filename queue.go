@@ -0,0 +1,438 @@
+package astibob
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	astiworker "github.com/asticode/go-astitools/worker"
+	"github.com/pkg/errors"
+)
+
+// Overflow policies
+const (
+	// Dispatch waits until there's room in the queue. This is the default policy.
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// The queued message with the same name and handler is replaced. If there's none, the oldest message is dropped.
+	OverflowPolicyCoalesce OverflowPolicy = "coalesce"
+	// The dispatched message is dropped
+	OverflowPolicyDropNewest OverflowPolicy = "drop_newest"
+	// The oldest queued message is dropped
+	OverflowPolicyDropOldest OverflowPolicy = "drop_oldest"
+)
+
+// Overflows are aggregated over this period before being reported
+var overflowPeriod = time.Second
+
+// OverflowPolicy defines what happens when a message is dispatched to a full queue
+type OverflowPolicy string
+
+// QueueOptions are the options of a dispatcher queue. A capacity <= 0 means the queue is unbounded.
+type QueueOptions struct {
+	Capacity int            `toml:"capacity"`
+	Policy   OverflowPolicy `toml:"policy"`
+}
+
+// DispatcherOptions are the options of a dispatcher. Queue options indexed by message name take precedence over queue
+// options indexed by key which take precedence over the default queue options. When set by message name, the capacity
+// only applies to messages with that name.
+type DispatcherOptions struct {
+	DeadLetters int                     `toml:"dead_letters"` // Maximum number of dead letters kept, defaults to 100
+	Keys        map[string]QueueOptions `toml:"keys"`
+	Names       map[string]QueueOptions `toml:"names"`
+	Queue       QueueOptions            `toml:"queue"`
+}
+
+// DispatcherOverflow describes messages dropped by a dispatcher queue
+type DispatcherOverflow struct {
+	Dropped int            `json:"dropped"` // Number of messages dropped since the previous overflow
+	Key     string         `json:"key"`
+	Names   []string       `json:"names"`
+	Policy  OverflowPolicy `json:"policy"`
+	Total   int            `json:"total"` // Number of messages dropped since the queue has been created
+}
+
+// DispatcherQueueStats represents the stats of a dispatcher queue
+type DispatcherQueueStats struct {
+	Dropped int    `json:"dropped"`
+	Expired int    `json:"expired"`
+	Key     string `json:"key"`
+	Length  int    `json:"length"`
+}
+
+// OrderingKeyFunc returns the ordering key of a message. Messages with the same ordering key are handled in FIFO order.
+type OrderingKeyFunc func(m *Message) string
+
+type queueConcurrency struct {
+	f OrderingKeyFunc
+	n int
+}
+
+type queueItem struct {
+	c       *dispatchCallback
+	h       MessageHandler
+	id      int // Handler id
+	m       *Message
+	n       string // Handler name
+	ok      string // Ordering key
+	ordered bool
+	t       *astiworker.Task
+}
+
+// finish must be called exactly once per item, whether it has been handled or not
+func (i *queueItem) finish(err error) {
+	// Task is done
+	if i.t != nil {
+		i.t.Done()
+	}
+
+	// Callback
+	if i.c != nil {
+		i.c.done(err)
+	}
+}
+
+type queue struct {
+	c       *sync.Cond // Its locker locks cn, dropped, expired, ih, is, ok, od, on, op and ot
+	cancel  context.CancelFunc
+	cn      int // Maximum number of items handled at the same time
+	ctx     context.Context
+	dropped int
+	ef      func(i *queueItem, err error, stack []byte)
+	expired int
+	ih      int // Number of items being handled
+	is      []*queueItem
+	k       string
+	ok      map[string]bool // Ordering keys of the items being handled
+	od      int             // Dropped since the previous overflow
+	of      func(o DispatcherOverflow)
+	on      map[string]bool // Names dropped since the previous overflow
+	op      OverflowPolicy
+	ot      *time.Timer
+}
+
+func newQueue(ctx context.Context, k string, of func(o DispatcherOverflow), ef func(i *queueItem, err error, stack []byte)) (q *queue) {
+	q = &queue{
+		c:  sync.NewCond(&sync.Mutex{}),
+		ef: ef,
+		k:  k,
+		of: of,
+		ok: make(map[string]bool),
+		on: make(map[string]bool),
+	}
+	q.ctx, q.cancel = context.WithCancel(ctx)
+	return
+}
+
+func (q *queue) setConcurrency(n int) {
+	q.c.L.Lock()
+	defer q.c.L.Unlock()
+	q.cn = n
+	q.c.Broadcast()
+}
+
+func (q *queue) start() {
+	// Handle context
+	go func() {
+		// Wait for context to be done
+		<-q.ctx.Done()
+
+		// Signal
+		q.c.L.Lock()
+		q.c.Broadcast()
+		q.c.L.Unlock()
+	}()
+
+	// Loop
+	for {
+		// Lock
+		q.c.L.Lock()
+
+		// Context has been cancelled
+		if q.ctx.Err() != nil {
+			q.close()
+			q.c.L.Unlock()
+			return
+		}
+
+		// No item can be handled
+		idx := q.next()
+		if idx < 0 {
+			q.c.Wait()
+			q.c.L.Unlock()
+			continue
+		}
+
+		// Get item
+		i := q.is[idx]
+		q.is = append(q.is[:idx], q.is[idx+1:]...)
+
+		// Update items being handled
+		q.ih++
+		if i.ordered {
+			q.ok[i.ok] = true
+		}
+		cn := q.cn
+
+		// Signal blocked dispatches
+		q.c.Broadcast()
+
+		// Unlock
+		q.c.L.Unlock()
+
+		// Execute
+		if cn > 1 {
+			go func() {
+				q.execute(i)
+				q.done(i)
+			}()
+		} else {
+			q.execute(i)
+			q.done(i)
+		}
+	}
+}
+
+// Returns the index of the first item that can be handled, or -1 if there's none. An ordered item can't be handled
+// while an item with the same ordering key is being handled, which keeps the FIFO order within an ordering key.
+// Assumes the locker is held.
+func (q *queue) next() int {
+	// Maximum number of items handled at the same time has been reached
+	if q.ih >= q.cn && q.ih > 0 {
+		return -1
+	}
+
+	// Loop through items
+	for idx, i := range q.is {
+		if !i.ordered || !q.ok[i.ok] {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (q *queue) done(i *queueItem) {
+	// Lock
+	q.c.L.Lock()
+	defer q.c.L.Unlock()
+
+	// Update items being handled
+	q.ih--
+	if i.ordered {
+		delete(q.ok, i.ok)
+	}
+
+	// Signal
+	q.c.Broadcast()
+}
+
+func (q *queue) execute(i *queueItem) {
+	// Message has expired while waiting in the queue
+	if i.m.Expired() {
+		q.expire(i.m)
+		i.finish(fmt.Errorf("astibob: message %s has expired", i.m.Name))
+		return
+	}
+
+	// Handle message
+	stack, err := handle(i.h, i.m)
+	if err != nil {
+		// Log
+		astilog.Error(errors.Wrap(err, "astibob: handling message failed"))
+
+		// Callback
+		if q.ef != nil {
+			q.ef(i, err, stack)
+		}
+	}
+
+	// Finish
+	i.finish(err)
+}
+
+// A panicking handler doesn't bring the process down, its stack is returned instead
+func handle(h MessageHandler, m *Message) (stack []byte, err error) {
+	// Recover
+	defer func() {
+		if v := recover(); v != nil {
+			stack = debug.Stack()
+			err = fmt.Errorf("astibob: handler panicked: %v", v)
+		}
+	}()
+
+	// Handle
+	err = h(m)
+	return
+}
+
+// Assumes the locker is held
+func (q *queue) close() {
+	// Loop through items
+	for _, i := range q.is {
+		i.finish(fmt.Errorf("astibob: dispatcher queue %s has been closed", q.k))
+	}
+	q.is = []*queueItem{}
+
+	// Stop overflow timer
+	if q.ot != nil {
+		q.ot.Stop()
+		q.ot = nil
+	}
+}
+
+func (q *queue) stop() {
+	q.cancel()
+}
+
+// When byName is true, only items with the same message name count towards the capacity
+func (q *queue) add(i *queueItem, o QueueOptions, byName bool) {
+	// Lock
+	q.c.L.Lock()
+	defer q.c.L.Unlock()
+
+	// Loop
+	for {
+		// Queue has been stopped
+		if q.ctx.Err() != nil {
+			i.finish(fmt.Errorf("astibob: dispatcher queue %s has been stopped", q.k))
+			return
+		}
+
+		// Get length
+		l := len(q.is)
+		if byName {
+			l = 0
+			for _, qi := range q.is {
+				if qi.m.Name == i.m.Name {
+					l++
+				}
+			}
+		}
+
+		// Queue is not full
+		if o.Capacity <= 0 || l < o.Capacity {
+			q.is = append(q.is, i)
+			q.c.Broadcast()
+			return
+		}
+
+		// Switch on policy
+		switch o.Policy {
+		case OverflowPolicyCoalesce:
+			// Replace item with the same name and handler
+			for idx, qi := range q.is {
+				if qi.m.Name == i.m.Name && qi.id == i.id {
+					q.is[idx] = i
+					q.drop(qi, o.Policy)
+					return
+				}
+			}
+
+			// Drop oldest
+			q.dropOldest(i, o.Policy, byName)
+			return
+		case OverflowPolicyDropNewest:
+			q.drop(i, o.Policy)
+			return
+		case OverflowPolicyDropOldest:
+			q.dropOldest(i, o.Policy, byName)
+			return
+		default:
+			q.c.Wait()
+		}
+	}
+}
+
+// Assumes the locker is held
+func (q *queue) dropOldest(i *queueItem, p OverflowPolicy, byName bool) {
+	// Loop through items
+	for idx, qi := range q.is {
+		if !byName || qi.m.Name == i.m.Name {
+			q.is = append(q.is[:idx], q.is[idx+1:]...)
+			q.drop(qi, p)
+			break
+		}
+	}
+
+	// Append
+	q.is = append(q.is, i)
+	q.c.Broadcast()
+}
+
+// Assumes the locker is held
+func (q *queue) drop(i *queueItem, p OverflowPolicy) {
+	// Finish
+	i.finish(fmt.Errorf("astibob: dispatcher queue %s dropped message %s", q.k, i.m.Name))
+
+	// Update counters
+	q.dropped++
+	q.od++
+	q.on[i.m.Name] = true
+	q.op = p
+
+	// Overflows are aggregated to avoid flooding
+	if q.ot == nil {
+		q.ot = time.AfterFunc(overflowPeriod, q.overflow)
+	}
+}
+
+func (q *queue) overflow() {
+	// Lock
+	q.c.L.Lock()
+
+	// Create overflow
+	o := DispatcherOverflow{
+		Dropped: q.od,
+		Key:     q.k,
+		Policy:  q.op,
+		Total:   q.dropped,
+	}
+	for n := range q.on {
+		o.Names = append(o.Names, n)
+	}
+	sort.Strings(o.Names)
+
+	// Reset
+	q.od = 0
+	q.on = make(map[string]bool)
+	q.ot = nil
+
+	// Unlock
+	q.c.L.Unlock()
+
+	// Log
+	astilog.Warnf("astibob: dispatcher queue %s dropped %d message(s) of %v", o.Key, o.Dropped, o.Names)
+
+	// Callback
+	if q.of != nil {
+		q.of(o)
+	}
+}
+
+func (q *queue) expire(m *Message) {
+	// Log
+	astilog.Debugf("astibob: dispatcher queue %s dropped expired message %s", q.k, m.Name)
+
+	// Update counter
+	q.c.L.Lock()
+	q.expired++
+	q.c.L.Unlock()
+}
+
+func (q *queue) stats() DispatcherQueueStats {
+	// Lock
+	q.c.L.Lock()
+	defer q.c.L.Unlock()
+
+	// Create stats
+	return DispatcherQueueStats{
+		Dropped: q.dropped,
+		Expired: q.expired,
+		Key:     q.k,
+		Length:  len(q.is),
+	}
+}
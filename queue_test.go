@@ -0,0 +1,153 @@
+package astibob
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type testQueueItem struct {
+	handler int
+	label   string
+	name    string
+}
+
+func newTestQueueItem(i testQueueItem) *queueItem {
+	m := NewMessage()
+	m.Name = i.name
+	m.Payload = []byte(i.label)
+	return &queueItem{
+		id: i.handler,
+		m:  m,
+	}
+}
+
+func testQueueLabels(q *queue) (ls []string) {
+	q.c.L.Lock()
+	defer q.c.L.Unlock()
+	ls = []string{}
+	for _, i := range q.is {
+		ls = append(ls, string(i.m.Payload))
+	}
+	return
+}
+
+func TestQueueOverflowPolicies(t *testing.T) {
+	for _, c := range []struct {
+		byName  bool
+		dropped int
+		e       []string
+		is      []testQueueItem
+		n       string
+		o       QueueOptions
+	}{
+		{
+			e:  []string{"1", "2", "3"},
+			is: []testQueueItem{{label: "1"}, {label: "2"}, {label: "3"}},
+			n:  "unbounded",
+		},
+		{
+			dropped: 1,
+			e:       []string{"2", "3"},
+			is:      []testQueueItem{{label: "1"}, {label: "2"}, {label: "3"}},
+			n:       "drop oldest",
+			o:       QueueOptions{Capacity: 2, Policy: OverflowPolicyDropOldest},
+		},
+		{
+			dropped: 1,
+			e:       []string{"1", "2"},
+			is:      []testQueueItem{{label: "1"}, {label: "2"}, {label: "3"}},
+			n:       "drop newest",
+			o:       QueueOptions{Capacity: 2, Policy: OverflowPolicyDropNewest},
+		},
+		{
+			dropped: 1,
+			e:       []string{"1", "4", "3"},
+			is: []testQueueItem{
+				{handler: 1, label: "1", name: "a"},
+				{handler: 1, label: "2", name: "b"},
+				{handler: 2, label: "3", name: "b"},
+				{handler: 1, label: "4", name: "b"},
+			},
+			n: "coalesce with same name and handler",
+			o: QueueOptions{Capacity: 3, Policy: OverflowPolicyCoalesce},
+		},
+		{
+			dropped: 1,
+			e:       []string{"2", "3"},
+			is: []testQueueItem{
+				{handler: 1, label: "1", name: "a"},
+				{handler: 1, label: "2", name: "b"},
+				{handler: 1, label: "3", name: "c"},
+			},
+			n: "coalesce without same name and handler",
+			o: QueueOptions{Capacity: 2, Policy: OverflowPolicyCoalesce},
+		},
+		{
+			byName:  true,
+			dropped: 2,
+			e:       []string{"3", "4"},
+			is: []testQueueItem{
+				{label: "1", name: "a"},
+				{label: "2", name: "b"},
+				{label: "3", name: "a"},
+				{label: "4", name: "b"},
+			},
+			n: "drop oldest by name",
+			o: QueueOptions{Capacity: 1, Policy: OverflowPolicyDropOldest},
+		},
+	} {
+		t.Run(c.n, func(t *testing.T) {
+			// Create queue without starting it so that items stay in it
+			q := newQueue(context.Background(), "test", nil, nil)
+			defer q.stop()
+
+			// Add
+			for _, i := range c.is {
+				q.add(newTestQueueItem(i), c.o, c.byName)
+			}
+
+			// Assert
+			if ls := testQueueLabels(q); !reflect.DeepEqual(ls, c.e) {
+				t.Errorf("expected %v, got %v", c.e, ls)
+			}
+			if s := q.stats(); s.Dropped != c.dropped {
+				t.Errorf("expected %d dropped, got %d", c.dropped, s.Dropped)
+			}
+		})
+	}
+}
+
+func TestQueueOverflowPolicyBlock(t *testing.T) {
+	// Fill queue
+	q := newQueue(context.Background(), "test", nil, nil)
+	defer q.stop()
+	o := QueueOptions{Capacity: 1, Policy: OverflowPolicyBlock}
+	q.add(newTestQueueItem(testQueueItem{label: "1"}), o, false)
+
+	// Add blocks
+	c := make(chan bool)
+	go func() {
+		q.add(newTestQueueItem(testQueueItem{label: "2"}), o, false)
+		close(c)
+	}()
+	select {
+	case <-c:
+		t.Fatal("expected add to block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Start queue
+	go q.start()
+
+	// Add returns once there's room
+	select {
+	case <-c:
+	case <-time.After(time.Second):
+		t.Fatal("expected add to return")
+	}
+	if s := q.stats(); s.Dropped != 0 {
+		t.Errorf("expected 0 dropped, got %d", s.Dropped)
+	}
+}
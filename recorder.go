@@ -0,0 +1,278 @@
+package astibob
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Default recorder options
+const (
+	defaultRecorderMaxFiles = 5
+	defaultRecorderMaxSize  = 100 << 20
+)
+
+// RecordedMessage is a line of a recording
+type RecordedMessage struct {
+	Message *Message  `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// RecorderOptions are the options of a recorder
+type RecorderOptions struct {
+	MaxFiles int    `toml:"max_files"` // Number of rotated files kept in addition to the current one, defaults to 5
+	MaxSize  int64  `toml:"max_size"`  // Size in bytes above which the file is rotated, defaults to 100MB
+	Path     string `toml:"path"`      // If set, messages are recorded to this file
+}
+
+// Recorder records messages to a JSONL file which is rotated once it has reached its maximum size. Rotated files
+// get a ".1", ".2", etc. suffix, ".1" being the most recent one.
+type Recorder struct {
+	f *os.File
+	m *sync.Mutex // Locks f, p and s
+	o RecorderOptions
+	p bool // Files have been shifted but the new file hasn't been opened yet
+	s int64
+}
+
+// NewRecorder creates a new recorder
+func NewRecorder(o RecorderOptions) (r *Recorder, err error) {
+	// Create recorder
+	r = &Recorder{
+		m: &sync.Mutex{},
+		o: o,
+	}
+
+	// Default options
+	if r.o.MaxFiles <= 0 {
+		r.o.MaxFiles = defaultRecorderMaxFiles
+	}
+	if r.o.MaxSize <= 0 {
+		r.o.MaxSize = defaultRecorderMaxSize
+	}
+
+	// Open
+	if r.f, r.s, err = openRecorderFile(r.o.Path); err != nil {
+		err = errors.Wrap(err, "astibob: opening recorder failed")
+		return
+	}
+	return
+}
+
+func openRecorderFile(path string) (f *os.File, s int64, err error) {
+	// Open file
+	if f, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644); err != nil {
+		err = errors.Wrapf(err, "astibob: opening %s failed", path)
+		return
+	}
+
+	// Get size
+	var fi os.FileInfo
+	if fi, err = f.Stat(); err != nil {
+		f.Close()
+		f = nil
+		err = errors.Wrapf(err, "astibob: stating %s failed", path)
+		return
+	}
+	s = fi.Size()
+	return
+}
+
+// Close closes the recorder properly
+func (r *Recorder) Close() error {
+	// Lock
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	// Close file
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+// Record records a message
+func (r *Recorder) Record(m *Message) (err error) {
+	// Marshal
+	var b []byte
+	if b, err = json.Marshal(RecordedMessage{
+		Message: m,
+		Time:    time.Now(),
+	}); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling recorded message failed")
+		return
+	}
+	b = append(b, '\n')
+
+	// Lock
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	// Recorder is closed
+	if r.f == nil {
+		return
+	}
+
+	// Rotate
+	// If rotating fails, the message is still recorded to the current file
+	if r.s > 0 && r.s+int64(len(b)) > r.o.MaxSize {
+		if err = r.rotate(); err != nil {
+			err = errors.Wrap(err, "astibob: rotating failed")
+		}
+	}
+
+	// Write
+	n, werr := r.f.Write(b)
+	r.s += int64(n)
+	if werr != nil {
+		err = errors.Wrapf(werr, "astibob: writing to %s failed", r.o.Path)
+		return
+	}
+	return
+}
+
+// The current file is kept open until the new one is ready so that messages are still recorded if rotating fails, in
+// which case files are not shifted again on the next attempt. Assumes the mutex is held.
+func (r *Recorder) rotate() (err error) {
+	// Shift files
+	if !r.p {
+		// Remove oldest file
+		if err = os.Remove(recorderPath(r.o.Path, r.o.MaxFiles)); err != nil && !os.IsNotExist(err) {
+			err = errors.Wrapf(err, "astibob: removing %s failed", recorderPath(r.o.Path, r.o.MaxFiles))
+			return
+		}
+
+		// Loop through files
+		for idx := r.o.MaxFiles - 1; idx >= 0; idx-- {
+			if err = os.Rename(recorderPath(r.o.Path, idx), recorderPath(r.o.Path, idx+1)); err != nil && !os.IsNotExist(err) {
+				err = errors.Wrapf(err, "astibob: renaming %s failed", recorderPath(r.o.Path, idx))
+				return
+			}
+		}
+		r.p = true
+	}
+
+	// Open new file
+	var f *os.File
+	var s int64
+	if f, s, err = openRecorderFile(r.o.Path); err != nil {
+		err = errors.Wrap(err, "astibob: opening new file failed")
+		return
+	}
+	r.p = false
+
+	// Close old file
+	if err = r.f.Close(); err != nil {
+		err = errors.Wrapf(err, "astibob: closing %s failed", recorderPath(r.o.Path, 1))
+	}
+
+	// Swap files
+	r.f = f
+	r.s = s
+	return
+}
+
+func recorderPath(path string, idx int) string {
+	if idx == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s.%d", path, idx)
+}
+
+// ReplayOptions are the options of a replay
+type ReplayOptions struct {
+	From  *Identifier // If set, only messages whose sender matches it are replayed
+	Names []string    // If set, only messages whose name matches one of these patterns are replayed
+	Speed float64     // 2 replays messages twice as fast as they were recorded, defaults to 1. A negative speed replays them without waiting.
+}
+
+func (o ReplayOptions) match(m *Message) bool {
+	// Check from
+	if o.From != nil && !o.From.match(m.From) {
+		return false
+	}
+
+	// Check names
+	if len(o.Names) > 0 {
+		for _, n := range o.Names {
+			if Match(n, m.Name) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Replay reads a recording and executes the callback for each message matching the options, respecting the delays
+// between them. It stops when the context is done.
+func Replay(ctx context.Context, r io.Reader, o ReplayOptions, fn func(m *Message) error) (err error) {
+	// Default speed
+	if o.Speed == 0 {
+		o.Speed = 1
+	}
+
+	// Loop through lines
+	var prev time.Time
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 64<<20)
+	for s.Scan() {
+		// Empty line
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+
+		// Unmarshal
+		var rm RecordedMessage
+		if err = json.Unmarshal(s.Bytes(), &rm); err != nil {
+			err = errors.Wrap(err, "astibob: unmarshaling recorded message failed")
+			return
+		}
+
+		// No match
+		if rm.Message == nil || !o.match(rm.Message) {
+			continue
+		}
+
+		// Wait
+		if !prev.IsZero() && o.Speed > 0 {
+			if d := time.Duration(float64(rm.Time.Sub(prev)) / o.Speed); d > 0 {
+				t := time.NewTimer(d)
+				select {
+				case <-t.C:
+				case <-ctx.Done():
+					t.Stop()
+				}
+			}
+		}
+		prev = rm.Time
+
+		// Context is done
+		if ctx.Err() != nil {
+			err = errors.Wrap(ctx.Err(), "astibob: context error")
+			return
+		}
+
+		// Callback
+		if err = fn(rm.Message); err != nil {
+			err = errors.Wrapf(err, "astibob: replaying message %s failed", rm.Message.Name)
+			return
+		}
+	}
+
+	// Scanner error
+	if err = s.Err(); err != nil {
+		err = errors.Wrap(err, "astibob: scanning failed")
+		return
+	}
+	return
+}
@@ -5,15 +5,17 @@ import (
 	"sync"
 
 	"github.com/asticode/go-astilog"
-	astiptr "github.com/asticode/go-astitools/ptr"
 	astiworker "github.com/asticode/go-astitools/worker"
 	"github.com/pkg/errors"
 )
 
 // Statuses
 const (
-	RunningStatus = "running"
-	StoppedStatus = "stopped"
+	CrashedStatus  = "crashed"
+	RunningStatus  = "running"
+	StartingStatus = "starting"
+	StoppedStatus  = "stopped"
+	StoppingStatus = "stopping"
 )
 
 // Errors
@@ -25,43 +27,88 @@ type Runnable interface {
 	Metadata() Metadata
 	OnMessage(m *Message) error
 	SetDispatchFunc(f DispatchFunc)
+	SetMetrics(m *Metrics)
 	SetRootCtx(ctx context.Context)
+	SetStartedFunc(f StartedFunc)
+	SetStore(s Store)
 	SetTaskFunc(f astiworker.TaskFunc)
 	Start(ctx context.Context) error
 	Status() string
 	Stop()
 }
 
+// Concurrent is an optional interface runnables can implement to handle several messages at the same time. Messages
+// with the same ordering key are handled in FIFO order whereas messages with different ordering keys are handled in
+// parallel, up to the concurrency level. OnMessage must therefore be safe for concurrent use.
+type Concurrent interface {
+	Concurrency() int
+	OrderingKey(m *Message) string
+}
+
 type DispatchFunc func(m *Message)
 
+// StartedFunc is executed once the runnable has actually started
+type StartedFunc func()
+
 type BaseRunnableOptions struct {
-	Metadata  Metadata
-	OnMessage func(m *Message) error
-	OnStart   func(ctx context.Context) error
+	Metadata              Metadata
+	OnMessage             func(m *Message) error
+	OnMessageWithDispatch OnMessageWithDispatch // If set, OnMessage is ignored
+	OnStart               func(ctx context.Context) error
 }
 
+// OnMessageWithDispatch handles a message like OnMessage but is also provided with a dispatch func that adds the
+// messages it dispatches to the trace of the message being handled. It can be used after the handler has returned,
+// for instance once the message has been processed in the background.
+type OnMessageWithDispatch func(m *Message, dispatch DispatchFunc) error
+
 type BaseRunnable struct {
 	dispatchFunc DispatchFunc
+	metrics      *Metrics
+	ms           *sync.Mutex // Locks status
 	o            BaseRunnableOptions
 	oStart       *sync.Once
 	oStop        *sync.Once
 	rootCtx      context.Context
 	startCancel  context.CancelFunc
 	startCtx     context.Context
+	startedFunc  StartedFunc
 	status       string
+	store        Store
 	taskFunc     astiworker.TaskFunc
 }
 
 func NewBaseRunnable(o BaseRunnableOptions) *BaseRunnable {
 	return &BaseRunnable{
+		ms:     &sync.Mutex{},
 		o:      o,
 		oStart: &sync.Once{},
 		oStop:  &sync.Once{},
 		status: StoppedStatus,
+		store:  NewMemoryStore(),
 	}
 }
 
+// Dispatch dispatches a message. If it isn't traced yet, it starts a new trace. Messages dispatched while handling a
+// message should be dispatched with the dispatch func provided to OnMessageWithDispatch instead so that they belong to
+// its trace.
 func (r *BaseRunnable) Dispatch(m *Message) {
+	r.DispatchFrom(nil, m)
+}
+
+// DispatchFrom dispatches a message on behalf of a parent message, usually the one being handled. If it isn't traced
+// yet, it belongs to the trace of the parent message.
+func (r *BaseRunnable) DispatchFrom(parent *Message, m *Message) {
+	// Set trace
+	if m.Trace == nil {
+		var t *Trace
+		if parent != nil {
+			t = parent.Trace
+		}
+		m.Trace = t.Child()
+	}
+
+	// Dispatch
 	if r.dispatchFunc != nil {
 		r.dispatchFunc(m)
 	}
@@ -69,32 +116,55 @@ func (r *BaseRunnable) Dispatch(m *Message) {
 
 func (r *BaseRunnable) Metadata() Metadata { return r.o.Metadata }
 
+// Metrics returns the metrics exposed by the worker the runnable is registered to. It may be nil but its methods are
+// safe to use anyway.
+func (r *BaseRunnable) Metrics() *Metrics { return r.metrics }
+
+// Store returns the store of the runnable, namespaced by the worker it is registered to so that it only contains the
+// runnable's items. Until then, it's an in-memory store.
+func (r *BaseRunnable) Store() Store { return r.store }
+
 func (r *BaseRunnable) NewTask() *astiworker.Task { return r.taskFunc() }
 
 func (r *BaseRunnable) OnMessage(m *Message) (err error) {
 	// We need to send a done message
 	if m.ID > 0 {
 		defer func() {
-			// Create message
-			m, err := NewRunnableDoneMessage(&Identifier{
-				Name: astiptr.Str(m.From.WorkerName()),
-				Type: WorkerIdentifierType,
-			}, RunnableDone{
+			// Create done
+			d := RunnableDone{
 				ID:      m.ID,
+				Payload: m.reply,
 				Success: err == nil,
-			})
+			}
+
+			// Add error
+			if err != nil {
+				if v, ok := errors.Cause(err).(*Error); ok {
+					d.Error = v
+				} else {
+					d.Error = &Error{Message: err.Error()}
+				}
+			}
+
+			// Create message
+			dm, err := NewRunnableDoneMessage(m.From.Clone(), d)
 			if err != nil {
 				astilog.Error(errors.Wrap(err, "astibob: creating runnable done message failed"))
 				return
 			}
 
 			// Dispatch
-			r.Dispatch(m)
+			r.DispatchFrom(m, dm)
 		}()
 	}
 
 	// Custom
-	if r.o.OnMessage != nil {
+	if r.o.OnMessageWithDispatch != nil {
+		if err = r.o.OnMessageWithDispatch(m, func(dm *Message) { r.DispatchFrom(m, dm) }); err != nil {
+			err = errors.Wrap(err, "astibob: custom message handling failed")
+			return
+		}
+	} else if r.o.OnMessage != nil {
 		if err = r.o.OnMessage(m); err != nil {
 			err = errors.Wrap(err, "astibob: custom message handling failed")
 			return
@@ -107,11 +177,27 @@ func (r *BaseRunnable) RootCtx() context.Context { return r.rootCtx }
 
 func (r *BaseRunnable) SetDispatchFunc(f DispatchFunc) { r.dispatchFunc = f }
 
+func (r *BaseRunnable) SetMetrics(m *Metrics) { r.metrics = m }
+
 func (r *BaseRunnable) SetRootCtx(ctx context.Context) { r.rootCtx = ctx }
 
+func (r *BaseRunnable) SetStartedFunc(f StartedFunc) { r.startedFunc = f }
+
+func (r *BaseRunnable) SetStore(s Store) { r.store = s }
+
 func (r *BaseRunnable) SetTaskFunc(f astiworker.TaskFunc) { r.taskFunc = f }
 
-func (r *BaseRunnable) Status() string { return r.status }
+func (r *BaseRunnable) Status() string {
+	r.ms.Lock()
+	defer r.ms.Unlock()
+	return r.status
+}
+
+func (r *BaseRunnable) setStatus(s string) {
+	r.ms.Lock()
+	defer r.ms.Unlock()
+	r.status = s
+}
 
 func (r *BaseRunnable) Start(ctx context.Context) (err error) {
 	// Make sure it's started only once
@@ -123,7 +209,12 @@ func (r *BaseRunnable) Start(ctx context.Context) (err error) {
 		r.oStop = &sync.Once{}
 
 		// Update status
-		r.status = RunningStatus
+		r.setStatus(RunningStatus)
+
+		// Started
+		if r.startedFunc != nil {
+			r.startedFunc()
+		}
 
 		// Start
 		if r.o.OnStart != nil {
@@ -140,7 +231,11 @@ func (r *BaseRunnable) Start(ctx context.Context) (err error) {
 		}
 
 		// Update status
-		r.status = StoppedStatus
+		if err != nil && err != ErrContextCancelled {
+			r.setStatus(CrashedStatus)
+		} else {
+			r.setStatus(StoppedStatus)
+		}
 	})
 	return
 }
@@ -148,6 +243,13 @@ func (r *BaseRunnable) Start(ctx context.Context) (err error) {
 func (r *BaseRunnable) Stop() {
 	// Make sure it's stopped only once
 	r.oStop.Do(func() {
+		// Update status
+		r.ms.Lock()
+		if r.status == RunningStatus {
+			r.status = StoppingStatus
+		}
+		r.ms.Unlock()
+
 		// Cancel context
 		if r.startCancel != nil {
 			r.startCancel()
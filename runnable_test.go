@@ -0,0 +1,46 @@
+package astibob
+
+import "testing"
+
+func TestBaseRunnableTrace(t *testing.T) {
+	// Create runnable
+	r := NewBaseRunnable(BaseRunnableOptions{
+		OnMessageWithDispatch: func(m *Message, dispatch DispatchFunc) error {
+			dm := NewMessage()
+			dm.Name = "child"
+			dispatch(dm)
+			return nil
+		},
+	})
+	var ms []*Message
+	r.SetDispatchFunc(func(m *Message) { ms = append(ms, m) })
+
+	// Handle
+	m := NewMessage()
+	m.Name = "parent"
+	m.Trace = NewTrace()
+	if err := r.OnMessage(m); err != nil {
+		t.Fatalf("handling message failed: %v", err)
+	}
+
+	// Message dispatched while handling belongs to the trace of the handled message
+	if len(ms) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(ms))
+	}
+	if ms[0].Trace == nil {
+		t.Fatal("expected message to be traced")
+	}
+	if ms[0].Trace.TraceID != m.Trace.TraceID {
+		t.Errorf("expected trace id %s, got %s", m.Trace.TraceID, ms[0].Trace.TraceID)
+	}
+	if ms[0].Trace.ParentID != m.Trace.SpanID {
+		t.Errorf("expected parent id %s, got %s", m.Trace.SpanID, ms[0].Trace.ParentID)
+	}
+
+	// Message dispatched outside of a handler starts a new trace
+	om := NewMessage()
+	r.Dispatch(om)
+	if om.Trace == nil || om.Trace.TraceID == m.Trace.TraceID || om.Trace.ParentID != "" {
+		t.Errorf("expected a new trace, got %+v", om.Trace)
+	}
+}
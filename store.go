@@ -0,0 +1,479 @@
+package astibob
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Number of obsolete operations above which the log of a file store is compacted
+const storeCompactThreshold = 1000
+
+// Errors
+var (
+	ErrStoreClosed   = errors.New("astibob: store is closed")
+	ErrStoreEmptyKey = errors.New("astibob: key is empty")
+)
+
+// Store is a key-value store. Keys are listed in lexicographical order and watchers are notified of each operation
+// once it has been applied.
+type Store interface {
+	Batch(ops ...StoreOperation) error // Operations are applied atomically
+	Close() error
+	Delete(key string) error
+	Get(key string) (value []byte, ok bool, err error)
+	List(prefix string) ([]StoreItem, error)
+	Put(key string, value []byte) error
+	Watch(prefix string, fn StoreWatchFunc) (unwatch func())
+}
+
+// StoreItem is a key-value pair
+type StoreItem struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// StoreOperation is either a put or, if Delete is true, a delete
+type StoreOperation struct {
+	Delete bool   `json:"delete,omitempty"`
+	Key    string `json:"key"`
+	Value  []byte `json:"value,omitempty"`
+}
+
+// StoreWatchFunc is called after an operation has been applied. It must not block.
+type StoreWatchFunc func(op StoreOperation)
+
+type storeWatcher struct {
+	fn     StoreWatchFunc
+	prefix string
+}
+
+// store is an in-memory store whose operations are appended to a log file when a path is provided
+type store struct {
+	c  bool
+	f  *os.File
+	is map[string][]byte
+	m  *sync.Mutex // Locks c, f, is, n, wi and ws
+	n  int         // Number of operations in the log
+	p  string
+	wi int
+	ws map[int]storeWatcher
+}
+
+func newStore(p string) *store {
+	return &store{
+		is: make(map[string][]byte),
+		m:  &sync.Mutex{},
+		p:  p,
+		ws: make(map[int]storeWatcher),
+	}
+}
+
+// NewMemoryStore creates a store whose items are lost once it's closed, which is useful in tests
+func NewMemoryStore() Store {
+	return newStore("")
+}
+
+// NewFileStore creates a store persisted in a single file. Operations are appended to the file, one JSON line per
+// batch so that a crash never leaves a partial batch behind, and the file is compacted when it's opened and once
+// obsolete operations pile up.
+func NewFileStore(path string) (s Store, err error) {
+	// Create store
+	st := newStore(path)
+
+	// Load
+	if err = st.load(); err != nil {
+		err = errors.Wrapf(err, "astibob: loading %s failed", path)
+		return
+	}
+
+	// Compact
+	if err = st.compact(); err != nil {
+		err = errors.Wrapf(err, "astibob: compacting %s failed", path)
+		return
+	}
+	return st, nil
+}
+
+func (s *store) load() (err error) {
+	// Open file
+	var f *os.File
+	if f, err = os.Open(s.p); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+			return
+		}
+		err = errors.Wrapf(err, "astibob: opening %s failed", s.p)
+		return
+	}
+	defer f.Close()
+
+	// Loop through lines
+	r := bufio.NewReader(f)
+	for {
+		// Read line
+		var b []byte
+		if b, err = r.ReadBytes('\n'); err != nil {
+			if err == io.EOF {
+				// The last line is incomplete which means the process has crashed while writing it
+				if len(bytes.TrimSpace(b)) > 0 {
+					astilog.Warnf("astibob: ignoring incomplete batch at the end of %s", s.p)
+				}
+				err = nil
+				break
+			}
+			err = errors.Wrapf(err, "astibob: reading %s failed", s.p)
+			return
+		}
+
+		// Unmarshal
+		var ops []StoreOperation
+		if err = json.Unmarshal(b, &ops); err != nil {
+			err = errors.Wrapf(err, "astibob: unmarshaling line %s failed", b)
+			return
+		}
+
+		// Apply
+		s.apply(ops)
+	}
+	return
+}
+
+// Assumes the mutex is held
+func (s *store) apply(ops []StoreOperation) {
+	for _, op := range ops {
+		if op.Delete {
+			delete(s.is, op.Key)
+		} else {
+			s.is[op.Key] = copyStoreValue(op.Value)
+		}
+		s.n++
+	}
+}
+
+// Items are written to a temporary file which then replaces the log so that the log is left untouched if anything
+// fails. Assumes the mutex is held.
+func (s *store) compact() (err error) {
+	// Create dir
+	if err = os.MkdirAll(filepath.Dir(s.p), 0755); err != nil {
+		err = errors.Wrapf(err, "astibob: mkdirall %s failed", filepath.Dir(s.p))
+		return
+	}
+
+	// Create temporary file
+	// It's opened in append mode since it becomes the log once renamed
+	var f *os.File
+	if f, err = os.OpenFile(s.p+".tmp", os.O_APPEND|os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644); err != nil {
+		err = errors.Wrapf(err, "astibob: creating %s failed", s.p+".tmp")
+		return
+	}
+
+	// Write items in a single batch
+	var ops []StoreOperation
+	for k, v := range s.is {
+		ops = append(ops, StoreOperation{
+			Key:   k,
+			Value: v,
+		})
+	}
+	if len(ops) > 0 {
+		if err = writeStoreBatch(f, ops); err != nil {
+			f.Close()
+			err = errors.Wrap(err, "astibob: writing batch failed")
+			return
+		}
+	}
+
+	// Sync temporary file
+	if err = f.Sync(); err != nil {
+		f.Close()
+		err = errors.Wrapf(err, "astibob: syncing %s failed", s.p+".tmp")
+		return
+	}
+
+	// Rename
+	// The previous file is kept until then so that operations are still persisted if anything fails
+	if err = os.Rename(s.p+".tmp", s.p); err != nil {
+		f.Close()
+		err = errors.Wrapf(err, "astibob: renaming %s failed", s.p+".tmp")
+		return
+	}
+
+	// Close previous file
+	if s.f != nil {
+		s.f.Close()
+	}
+	s.f = f
+
+	// Update number of operations
+	s.n = len(ops)
+	return
+}
+
+// Values are copied when they're stored and returned so that callers can't modify stored values
+func copyStoreValue(v []byte) []byte {
+	if v == nil {
+		return nil
+	}
+	return append([]byte{}, v...)
+}
+
+func writeStoreBatch(w io.Writer, ops []StoreOperation) (err error) {
+	// Marshal
+	var b []byte
+	if b, err = json.Marshal(ops); err != nil {
+		err = errors.Wrap(err, "astibob: marshaling failed")
+		return
+	}
+
+	// Write
+	if _, err = w.Write(append(b, '\n')); err != nil {
+		err = errors.Wrap(err, "astibob: writing failed")
+		return
+	}
+	return
+}
+
+func (s *store) Batch(ops ...StoreOperation) (err error) {
+	// Nothing to do
+	if len(ops) == 0 {
+		return
+	}
+
+	// Check keys
+	for _, op := range ops {
+		if op.Key == "" {
+			err = ErrStoreEmptyKey
+			return
+		}
+	}
+
+	// Lock
+	s.m.Lock()
+
+	// Store is closed
+	if s.c {
+		s.m.Unlock()
+		err = ErrStoreClosed
+		return
+	}
+
+	// Write
+	if s.f != nil {
+		if err = writeStoreBatch(s.f, ops); err != nil {
+			s.m.Unlock()
+			err = errors.Wrap(err, "astibob: writing batch failed")
+			return
+		}
+	}
+
+	// Apply
+	s.apply(ops)
+
+	// Compact
+	if s.f != nil && s.n-len(s.is) > storeCompactThreshold {
+		if err := s.compact(); err != nil {
+			astilog.Error(errors.Wrapf(err, "astibob: compacting %s failed", s.p))
+		}
+	}
+
+	// Get watchers
+	var ws []storeWatcher
+	for _, w := range s.ws {
+		ws = append(ws, w)
+	}
+
+	// Unlock
+	s.m.Unlock()
+
+	// Notify
+	for _, op := range ops {
+		for _, w := range ws {
+			if strings.HasPrefix(op.Key, w.prefix) {
+				w.fn(op)
+			}
+		}
+	}
+	return
+}
+
+func (s *store) Close() (err error) {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Update closed
+	s.c = true
+
+	// Close file
+	if s.f != nil {
+		if err = s.f.Close(); err != nil {
+			err = errors.Wrapf(err, "astibob: closing %s failed", s.p)
+			return
+		}
+		s.f = nil
+	}
+	return
+}
+
+func (s *store) Delete(key string) error {
+	return s.Batch(StoreOperation{
+		Delete: true,
+		Key:    key,
+	})
+}
+
+func (s *store) Get(key string) (value []byte, ok bool, err error) {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Store is closed
+	if s.c {
+		err = ErrStoreClosed
+		return
+	}
+
+	// Get
+	if value, ok = s.is[key]; ok {
+		value = copyStoreValue(value)
+	}
+	return
+}
+
+func (s *store) List(prefix string) (is []StoreItem, err error) {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Store is closed
+	if s.c {
+		err = ErrStoreClosed
+		return
+	}
+
+	// Loop through items
+	is = []StoreItem{}
+	for k, v := range s.is {
+		if strings.HasPrefix(k, prefix) {
+			is = append(is, StoreItem{
+				Key:   k,
+				Value: copyStoreValue(v),
+			})
+		}
+	}
+
+	// Sort
+	sort.Slice(is, func(i, j int) bool { return is[i].Key < is[j].Key })
+	return
+}
+
+func (s *store) Put(key string, value []byte) error {
+	return s.Batch(StoreOperation{
+		Key:   key,
+		Value: value,
+	})
+}
+
+func (s *store) Watch(prefix string, fn StoreWatchFunc) (unwatch func()) {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Add watcher
+	s.wi++
+	id := s.wi
+	s.ws[id] = storeWatcher{
+		fn:     fn,
+		prefix: prefix,
+	}
+	return func() {
+		s.m.Lock()
+		defer s.m.Unlock()
+		delete(s.ws, id)
+	}
+}
+
+// namespacedStore prefixes keys with its namespace so that several owners can share the same store without seeing
+// each other's items
+type namespacedStore struct {
+	ns string
+	s  Store
+}
+
+// NewNamespacedStore creates a view of the store only containing the keys prefixed with the namespace, which is
+// hidden from the view's keys. Closing the view doesn't close the store.
+func NewNamespacedStore(s Store, namespace string) Store {
+	return &namespacedStore{
+		ns: namespace + "/",
+		s:  s,
+	}
+}
+
+func (s *namespacedStore) Batch(ops ...StoreOperation) error {
+	// Loop through operations
+	nops := make([]StoreOperation, len(ops))
+	for idx, op := range ops {
+		// Check key
+		if op.Key == "" {
+			return ErrStoreEmptyKey
+		}
+
+		// Add namespace
+		op.Key = s.ns + op.Key
+		nops[idx] = op
+	}
+	return s.s.Batch(nops...)
+}
+
+func (s *namespacedStore) Close() error { return nil }
+
+func (s *namespacedStore) Delete(key string) error {
+	return s.Batch(StoreOperation{
+		Delete: true,
+		Key:    key,
+	})
+}
+
+func (s *namespacedStore) Get(key string) ([]byte, bool, error) {
+	if key == "" {
+		return nil, false, ErrStoreEmptyKey
+	}
+	return s.s.Get(s.ns + key)
+}
+
+func (s *namespacedStore) List(prefix string) (is []StoreItem, err error) {
+	// List
+	if is, err = s.s.List(s.ns + prefix); err != nil {
+		return
+	}
+
+	// Remove namespace
+	for idx := range is {
+		is[idx].Key = strings.TrimPrefix(is[idx].Key, s.ns)
+	}
+	return
+}
+
+func (s *namespacedStore) Put(key string, value []byte) error {
+	return s.Batch(StoreOperation{
+		Key:   key,
+		Value: value,
+	})
+}
+
+func (s *namespacedStore) Watch(prefix string, fn StoreWatchFunc) (unwatch func()) {
+	return s.s.Watch(s.ns+prefix, func(op StoreOperation) {
+		op.Key = strings.TrimPrefix(op.Key, s.ns)
+		fn(op)
+	})
+}
@@ -0,0 +1,175 @@
+package astibob
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func testStorePath(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "astibob-store-")
+	if err != nil {
+		t.Fatalf("creating dir failed: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return filepath.Join(dir, "store", "store.log")
+}
+
+func testStoreLines(t *testing.T, p string) (n int) {
+	t.Helper()
+	f, err := os.Open(p)
+	if err != nil {
+		t.Fatalf("opening %s failed: %v", p, err)
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		n++
+	}
+	return
+}
+
+func assertStoreItems(t *testing.T, s Store, e []StoreItem) {
+	t.Helper()
+	is, err := s.List("")
+	if err != nil {
+		t.Fatalf("listing failed: %v", err)
+	}
+	if !reflect.DeepEqual(is, e) {
+		t.Errorf("expected %+v, got %+v", e, is)
+	}
+}
+
+func TestFileStoreReopen(t *testing.T) {
+	// Open
+	p := testStorePath(t)
+	s, err := NewFileStore(p)
+	if err != nil {
+		t.Fatalf("opening store failed: %v", err)
+	}
+
+	// Write
+	s.Put("a", []byte("1"))
+	s.Put("b", []byte("2"))
+	s.Put("a", []byte("3"))
+	s.Delete("b")
+	s.Batch(StoreOperation{Key: "c", Value: []byte("4")}, StoreOperation{Key: "d", Value: []byte("5")})
+
+	// Close
+	if err = s.Close(); err != nil {
+		t.Fatalf("closing store failed: %v", err)
+	}
+	if _, _, err = s.Get("a"); err != ErrStoreClosed {
+		t.Errorf("expected ErrStoreClosed, got %v", err)
+	}
+
+	// Reopen
+	if s, err = NewFileStore(p); err != nil {
+		t.Fatalf("reopening store failed: %v", err)
+	}
+	defer s.Close()
+	assertStoreItems(t, s, []StoreItem{
+		{Key: "a", Value: []byte("3")},
+		{Key: "c", Value: []byte("4")},
+		{Key: "d", Value: []byte("5")},
+	})
+
+	// Log has been compacted when reopened
+	if n := testStoreLines(t, p); n != 1 {
+		t.Errorf("expected 1 line, got %d", n)
+	}
+}
+
+func TestFileStoreIncompleteBatch(t *testing.T) {
+	// Open
+	p := testStorePath(t)
+	s, err := NewFileStore(p)
+	if err != nil {
+		t.Fatalf("opening store failed: %v", err)
+	}
+	s.Put("a", []byte("1"))
+	s.Close()
+
+	// Simulate a crash while writing a batch
+	f, err := os.OpenFile(p, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("opening %s failed: %v", p, err)
+	}
+	f.Write([]byte(`[{"key":"b","value":"`))
+	f.Close()
+
+	// Reopen
+	if s, err = NewFileStore(p); err != nil {
+		t.Fatalf("reopening store failed: %v", err)
+	}
+	defer s.Close()
+	assertStoreItems(t, s, []StoreItem{{Key: "a", Value: []byte("1")}})
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	// Open
+	p := testStorePath(t)
+	s, err := NewFileStore(p)
+	if err != nil {
+		t.Fatalf("opening store failed: %v", err)
+	}
+
+	// Write enough obsolete operations to trigger a compaction
+	for idx := 0; idx <= storeCompactThreshold+1; idx++ {
+		if err = s.Put("a", []byte(strconv.Itoa(idx))); err != nil {
+			t.Fatalf("putting failed: %v", err)
+		}
+	}
+	if n := testStoreLines(t, p); n > storeCompactThreshold {
+		t.Errorf("expected log to be compacted, got %d lines", n)
+	}
+
+	// Operations are still persisted after the compaction
+	if err = s.Put("b", []byte("b")); err != nil {
+		t.Fatalf("putting failed: %v", err)
+	}
+	s.Close()
+
+	// No temporary file is left behind
+	if _, err = os.Stat(p + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected no temporary file, got %v", err)
+	}
+
+	// Reopen
+	if s, err = NewFileStore(p); err != nil {
+		t.Fatalf("reopening store failed: %v", err)
+	}
+	defer s.Close()
+	assertStoreItems(t, s, []StoreItem{
+		{Key: "a", Value: []byte(strconv.Itoa(storeCompactThreshold + 1))},
+		{Key: "b", Value: []byte("b")},
+	})
+}
+
+func TestStoreCopiesValues(t *testing.T) {
+	s := NewMemoryStore()
+	defer s.Close()
+
+	// Put
+	v := []byte("value")
+	s.Put("a", v)
+	v[0] = 'x'
+
+	// Get
+	g, _, _ := s.Get("a")
+	if string(g) != "value" {
+		t.Errorf("expected value, got %s", g)
+	}
+	g[0] = 'x'
+
+	// List
+	is, _ := s.List("")
+	if string(is[0].Value) != "value" {
+		t.Errorf("expected value, got %s", is[0].Value)
+	}
+}
@@ -0,0 +1,97 @@
+package astibob
+
+import (
+	"context"
+	"sync"
+)
+
+// Subscription allows turning off what has been subscribed to
+type Subscription struct {
+	f func()
+	o *sync.Once
+}
+
+// NewSubscription creates a subscription that executes f the first time it's turned off
+func NewSubscription(f func()) *Subscription {
+	return &Subscription{
+		f: f,
+		o: &sync.Once{},
+	}
+}
+
+// Off turns off the subscription
+func (s *Subscription) Off() {
+	s.o.Do(s.f)
+}
+
+// OnFunc adds a handler executed when a message matches the conditions
+type OnFunc func(c DispatchConditions, h MessageHandler) *Subscription
+
+// Scope groups subscriptions so that they're all turned off together
+type Scope struct {
+	ctx  context.Context
+	done chan struct{} // Closed when the scope is turned off
+	f    OnFunc
+	m    *sync.Mutex // Locks done and ss
+	ss   []*Subscription
+}
+
+func newScope(ctx context.Context, f OnFunc) *Scope {
+	return &Scope{
+		ctx: ctx,
+		f:   f,
+		m:   &sync.Mutex{},
+	}
+}
+
+// On adds a handler that is removed when the scope is turned off
+func (s *Scope) On(c DispatchConditions, h MessageHandler) (o *Subscription) {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Add handler
+	o = s.f(c, h)
+
+	// Context is already done
+	if s.ctx.Err() != nil {
+		o.Off()
+		return
+	}
+
+	// Turn off subscriptions when context is done, unless the scope is turned off first
+	if s.done == nil {
+		done := make(chan struct{})
+		s.done = done
+		go func() {
+			select {
+			case <-s.ctx.Done():
+				s.Off()
+			case <-done:
+			}
+		}()
+	}
+
+	// Append subscription
+	s.ss = append(s.ss, o)
+	return
+}
+
+// Off turns off all subscriptions of the scope
+func (s *Scope) Off() {
+	// Lock
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	// Loop through subscriptions
+	for _, o := range s.ss {
+		o.Off()
+	}
+	s.ss = []*Subscription{}
+
+	// Stop waiting for the context
+	if s.done != nil {
+		close(s.done)
+		s.done = nil
+	}
+}
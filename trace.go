@@ -0,0 +1,340 @@
+package astibob
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Number of spans kept in memory when no capacity is provided
+const defaultTracerCapacity = 10000
+
+// Spans are exported at most once per period
+var tracerExportPeriod = time.Second
+
+// Trace identifies the span of a message as well as the trace it belongs to
+type Trace struct {
+	ParentID string `json:"parent_id,omitempty" msgpack:"parent_id,omitempty"`
+	SpanID   string `json:"span_id" msgpack:"span_id"`
+	TraceID  string `json:"trace_id" msgpack:"trace_id"`
+}
+
+// NewTrace creates the root span of a new trace
+func NewTrace() *Trace {
+	return &Trace{
+		SpanID:  newTraceID(8),
+		TraceID: newTraceID(16),
+	}
+}
+
+// IDs only need to be unique which is why a pseudo-random source is used when the system one fails
+func newTraceID(size int) string {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		astilog.Error(errors.Wrap(err, "astibob: reading random bytes failed"))
+		for idx := range b {
+			b[idx] = byte(mrand.Intn(256))
+		}
+	}
+	return hex.EncodeToString(b)
+}
+
+// Child creates a new span in the same trace whose parent is t. If t is nil, a new trace is created.
+func (t *Trace) Child() *Trace {
+	if t == nil {
+		return NewTrace()
+	}
+	return &Trace{
+		ParentID: t.SpanID,
+		SpanID:   newTraceID(8),
+		TraceID:  t.TraceID,
+	}
+}
+
+// Clone clones the trace
+func (t *Trace) Clone() *Trace {
+	if t == nil {
+		return nil
+	}
+	o := *t
+	return &o
+}
+
+// Span represents the handling of a message, in the Zipkin v2 JSON format. Timestamp and Duration are in microseconds.
+type Span struct {
+	Duration      int64             `json:"duration"`
+	ID            string            `json:"id"`
+	LocalEndpoint SpanEndpoint      `json:"localEndpoint"`
+	Name          string            `json:"name"`
+	ParentID      string            `json:"parentId,omitempty"`
+	Tags          map[string]string `json:"tags,omitempty"`
+	Timestamp     int64             `json:"timestamp"`
+	TraceID       string            `json:"traceId"`
+}
+
+type SpanEndpoint struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// TraceSummary sums up a trace. Timestamp and Duration are in microseconds.
+type TraceSummary struct {
+	Duration  int64    `json:"duration"`
+	Name      string   `json:"name"`
+	Services  []string `json:"services"`
+	Spans     int      `json:"spans"`
+	Timestamp int64    `json:"timestamp"`
+	TraceID   string   `json:"trace_id"`
+}
+
+// TracerOptions are the options of a tracer
+type TracerOptions struct {
+	Capacity int    `toml:"capacity"` // Maximum number of spans kept in memory, defaults to 10000
+	Path     string `toml:"path"`     // If set, spans kept in memory are exported to this file in the Zipkin v2 JSON format
+}
+
+// Tracer records the spans of the messages handled by a service
+type Tracer struct {
+	c  int
+	d  bool        // Whether spans have changed since the last export
+	m  *sync.Mutex // Locks d and ss
+	o  TracerOptions
+	s  string
+	ss []Span
+}
+
+// NewTracer creates a new tracer
+func NewTracer(serviceName string, o TracerOptions) (t *Tracer) {
+	// Create tracer
+	t = &Tracer{
+		c: o.Capacity,
+		m: &sync.Mutex{},
+		o: o,
+		s: serviceName,
+	}
+
+	// Default capacity
+	if t.c <= 0 {
+		t.c = defaultTracerCapacity
+	}
+	return
+}
+
+// Start exports spans periodically until the context is done
+func (t *Tracer) Start(ctx context.Context) {
+	// No path
+	if t.o.Path == "" {
+		return
+	}
+
+	// Create ticker
+	k := time.NewTicker(tracerExportPeriod)
+	defer k.Stop()
+
+	// Loop
+	for {
+		select {
+		case <-k.C:
+			t.export()
+		case <-ctx.Done():
+			t.export()
+			return
+		}
+	}
+}
+
+func (t *Tracer) export() {
+	// Lock
+	t.m.Lock()
+
+	// Nothing has changed
+	if !t.d {
+		t.m.Unlock()
+		return
+	}
+
+	// Marshal
+	b, err := json.Marshal(t.ss)
+	t.d = false
+	t.m.Unlock()
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "astibob: marshaling spans failed"))
+		return
+	}
+
+	// Write to a temporary file first so that readers never see a partial export
+	tmp := t.o.Path + ".tmp"
+	if err = ioutil.WriteFile(tmp, b, 0644); err != nil {
+		astilog.Error(errors.Wrapf(err, "astibob: writing spans to %s failed", tmp))
+		return
+	}
+
+	// Rename
+	if err = os.Rename(tmp, filepath.Clean(t.o.Path)); err != nil {
+		astilog.Error(errors.Wrapf(err, "astibob: renaming %s to %s failed", tmp, t.o.Path))
+		return
+	}
+}
+
+// Record records a span. Oldest spans are removed first once the capacity is reached.
+func (t *Tracer) Record(s Span) {
+	// Lock
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// Append
+	t.ss = append(t.ss, s)
+	if len(t.ss) > t.c {
+		t.ss = append([]Span{}, t.ss[len(t.ss)-t.c:]...)
+	}
+	t.d = true
+}
+
+// Handler wraps a handler so that a span is recorded each time it handles a traced message
+func (t *Tracer) Handler(tags map[string]string, h MessageHandler) MessageHandler {
+	return func(m *Message) (err error) {
+		// Not traced
+		if m.Trace == nil {
+			return h(m)
+		}
+
+		// Record span
+		start := time.Now()
+		defer func() {
+			// Create span
+			s := Span{
+				Duration:      int64(time.Since(start) / time.Microsecond),
+				ID:            m.Trace.SpanID,
+				LocalEndpoint: SpanEndpoint{ServiceName: t.s},
+				Name:          m.Name,
+				ParentID:      m.Trace.ParentID,
+				Tags:          map[string]string{"from": m.From.String()},
+				Timestamp:     start.UnixNano() / int64(time.Microsecond),
+				TraceID:       m.Trace.TraceID,
+			}
+
+			// Add tags
+			for k, v := range tags {
+				s.Tags[k] = v
+			}
+			if err != nil {
+				s.Tags["error"] = err.Error()
+			}
+
+			// Record
+			t.Record(s)
+		}()
+
+		// Handle
+		err = h(m)
+		return
+	}
+}
+
+// Spans returns the spans of a trace ordered by timestamp
+func (t *Tracer) Spans(traceID string) (ss []Span) {
+	// Lock
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// Loop through spans
+	ss = []Span{}
+	for _, s := range t.ss {
+		if s.TraceID == traceID {
+			ss = append(ss, s)
+		}
+	}
+
+	// Sort
+	SortSpans(ss)
+	return
+}
+
+// Traces returns the summaries of the traces whose spans are kept in memory, most recent first
+func (t *Tracer) Traces() []TraceSummary {
+	// Lock
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	// Summarize
+	return SummarizeSpans(t.ss)
+}
+
+// SortSpans sorts spans by timestamp
+func SortSpans(ss []Span) {
+	sort.SliceStable(ss, func(i, j int) bool { return ss[i].Timestamp < ss[j].Timestamp })
+}
+
+// SummarizeSpans summarizes spans per trace, most recent trace first
+func SummarizeSpans(ss []Span) (ts []TraceSummary) {
+	// Loop through spans
+	type summary struct {
+		end int64
+		rs  bool            // Whether the name comes from a root span
+		ss  map[string]bool // Services
+		t   *TraceSummary
+	}
+	ms := make(map[string]*summary)
+	for _, s := range ss {
+		// Get summary
+		m, ok := ms[s.TraceID]
+		if !ok {
+			m = &summary{
+				ss: make(map[string]bool),
+				t: &TraceSummary{
+					Name:      s.Name,
+					Timestamp: s.Timestamp,
+					TraceID:   s.TraceID,
+				},
+			}
+			ms[s.TraceID] = m
+		}
+
+		// Update summary
+		m.ss[s.LocalEndpoint.ServiceName] = true
+		m.t.Spans++
+		if s.Timestamp < m.t.Timestamp {
+			m.t.Timestamp = s.Timestamp
+			if !m.rs {
+				m.t.Name = s.Name
+			}
+		}
+		if e := s.Timestamp + s.Duration; e > m.end {
+			m.end = e
+		}
+		if s.ParentID == "" && !m.rs {
+			m.rs = true
+			m.t.Name = s.Name
+		}
+	}
+
+	// Loop through summaries
+	ts = []TraceSummary{}
+	for _, m := range ms {
+		// Update duration
+		m.t.Duration = m.end - m.t.Timestamp
+
+		// Add services
+		for s := range m.ss {
+			m.t.Services = append(m.t.Services, s)
+		}
+		sort.Strings(m.t.Services)
+
+		// Append
+		ts = append(ts, *m.t)
+	}
+
+	// Sort
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Timestamp > ts[j].Timestamp })
+	return
+}
@@ -0,0 +1,78 @@
+package astibob
+
+import (
+	"context"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// Transports wait for this period before reconnecting
+var transportRetryPeriod = time.Second
+
+// Transport carries marshaled messages between the index and the workers, as well as between workers
+type Transport interface {
+	// DialIndex connects a worker to the index and reconnects whenever the connection is lost. It blocks until the
+	// context is done.
+	DialIndex(ctx context.Context, hs TransportHandlers)
+	// ListenIndex accepts connections of workers until the context is done. It doesn't block.
+	ListenIndex(ctx context.Context, hs TransportHandlers) error
+	// ListenWorker accepts messages sent to a worker by other workers until the context is done. It doesn't block.
+	ListenWorker(ctx context.Context, name string, h TransportHandler) error
+	// SendToWorker sends a message to another worker. Transports pick the worker's name or its HTTP address depending
+	// on how they reach workers.
+	SendToWorker(ctx context.Context, name, addr, contentType string, p []byte) error
+}
+
+// TransportHandler handles a payload together with the content type of the codec it has been marshaled with
+type TransportHandler func(contentType string, p []byte) error
+
+// TransportConn is a connection between a worker and the index. Payloads are written together with the content type
+// of the codec they have been marshaled with so that the other side knows how to unmarshal them.
+type TransportConn interface {
+	Close() error
+	Write(contentType string, p []byte) error
+}
+
+// TransportHandlers are the callbacks a transport executes for connections between a worker and the index
+type TransportHandlers struct {
+	OnClose   func(c TransportConn)
+	OnMessage func(c TransportConn, contentType string, p []byte) error
+	OnOpen    func(c TransportConn) error
+}
+
+func (hs TransportHandlers) close(c TransportConn) {
+	if hs.OnClose != nil {
+		hs.OnClose(c)
+	}
+}
+
+func (hs TransportHandlers) message(c TransportConn, contentType string, p []byte) error {
+	if hs.OnMessage != nil {
+		return hs.OnMessage(c, contentType, p)
+	}
+	return nil
+}
+
+func (hs TransportHandlers) open(c TransportConn) error {
+	if hs.OnOpen != nil {
+		return hs.OnOpen(c)
+	}
+	return nil
+}
+
+// RoutedTransport is implemented by transports whose routes are served by the HTTP server of the index and of the
+// workers. Routes are indexed by path --> method.
+type RoutedTransport interface {
+	Routes() map[string]map[string]httprouter.Handle
+}
+
+// Waits for the retry period unless the context is done first
+func sleepTransportRetry(ctx context.Context) {
+	t := time.NewTimer(transportRetryPeriod)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
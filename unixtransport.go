@@ -0,0 +1,370 @@
+package astibob
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Payloads bigger than this are rejected
+const unixTransportMaxPayloadSize = 64 << 20
+
+// Writes taking longer than this fail, unless the context has an earlier deadline
+const unixTransportWriteTimeout = 10 * time.Second
+
+// UnixTransportOptions are the options of a unix transport
+type UnixTransportOptions struct {
+	Dir string `toml:"dir"` // Directory containing the sockets of the index and of the workers
+}
+
+// UnixTransport is a transport for an index and workers running on the same host. The index listens on the
+// "index.sock" socket and each worker on a socket named after it, all located in the same directory. Payloads are
+// prefixed with the content type of their codec and with their length.
+type UnixTransport struct {
+	cs map[string]*unixTransportConn // Connections to other workers indexed by name
+	m  *sync.Mutex                   // Locks cs
+	o  UnixTransportOptions
+}
+
+// NewUnixTransport creates a new unix transport
+func NewUnixTransport(o UnixTransportOptions) *UnixTransport {
+	return &UnixTransport{
+		cs: make(map[string]*unixTransportConn),
+		m:  &sync.Mutex{},
+		o:  o,
+	}
+}
+
+func (t *UnixTransport) indexPath() string {
+	return filepath.Join(t.o.Dir, "index.sock")
+}
+
+func (t *UnixTransport) workerPath(name string) string {
+	return filepath.Join(t.o.Dir, url.PathEscape(name)+".worker.sock")
+}
+
+type unixTransportConn struct {
+	c net.Conn
+	m *sync.Mutex // Locks c and writes
+}
+
+func newUnixTransportConn(c net.Conn) *unixTransportConn {
+	return &unixTransportConn{
+		c: c,
+		m: &sync.Mutex{},
+	}
+}
+
+func (c *unixTransportConn) Close() error {
+	return c.c.Close()
+}
+
+func (c *unixTransportConn) Write(contentType string, p []byte) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.write(context.Background(), contentType, p)
+}
+
+// Assumes the mutex is held
+func (c *unixTransportConn) write(ctx context.Context, contentType string, p []byte) (err error) {
+	// Set write deadline
+	d := time.Now().Add(unixTransportWriteTimeout)
+	if cd, ok := ctx.Deadline(); ok && cd.Before(d) {
+		d = cd
+	}
+	if err = c.c.SetWriteDeadline(d); err != nil {
+		err = errors.Wrap(err, "astibob: setting write deadline failed")
+		return
+	}
+
+	// Write
+	if err = writeUnixTransportPayload(c.c, contentType, p); err != nil {
+		err = errors.Wrap(err, "astibob: writing payload failed")
+		return
+	}
+	return
+}
+
+// Frames are made of the length of the content type on 1 byte, the content type, the length of the payload on 4
+// bytes and the payload
+func writeUnixTransportPayload(w io.Writer, contentType string, p []byte) (err error) {
+	// Invalid content type
+	if len(contentType) > 255 {
+		err = fmt.Errorf("astibob: content type %s is too long", contentType)
+		return
+	}
+
+	// Create frame
+	b := make([]byte, 0, 5+len(contentType)+len(p))
+	b = append(b, byte(len(contentType)))
+	b = append(b, contentType...)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(p)))
+	b = append(b, p...)
+
+	// Write
+	if _, err = w.Write(b); err != nil {
+		err = errors.Wrap(err, "astibob: writing failed")
+		return
+	}
+	return
+}
+
+// Reads payloads until an error occurs
+func readUnixTransportPayloads(r io.Reader, fn func(contentType string, p []byte)) (err error) {
+	br := bufio.NewReader(r)
+	b := make([]byte, 4)
+	for {
+		// Read content type
+		var l byte
+		if l, err = br.ReadByte(); err != nil {
+			return
+		}
+		ct := make([]byte, l)
+		if _, err = io.ReadFull(br, ct); err != nil {
+			return
+		}
+
+		// Read length
+		if _, err = io.ReadFull(br, b); err != nil {
+			return
+		}
+
+		// Invalid length
+		s := binary.BigEndian.Uint32(b)
+		if s > unixTransportMaxPayloadSize {
+			err = fmt.Errorf("astibob: payload size %d is too big", s)
+			return
+		}
+
+		// Read payload
+		p := make([]byte, s)
+		if _, err = io.ReadFull(br, p); err != nil {
+			return
+		}
+
+		// Callback
+		fn(string(ct), p)
+	}
+}
+
+// Removes the socket left behind by a previous process before listening
+func listenUnix(ctx context.Context, path string, fn func(c net.Conn)) (err error) {
+	// Remove previous socket
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		err = errors.Wrapf(err, "astibob: removing %s failed", path)
+		return
+	}
+
+	// Listen
+	var l net.Listener
+	if l, err = net.Listen("unix", path); err != nil {
+		err = errors.Wrapf(err, "astibob: listening on %s failed", path)
+		return
+	}
+
+	// Close listener and connections when the context is done
+	cs := make(map[net.Conn]bool)
+	m := &sync.Mutex{} // Locks cs
+	go func() {
+		// Wait
+		<-ctx.Done()
+
+		// Close listener
+		l.Close()
+
+		// Close connections
+		m.Lock()
+		for c := range cs {
+			c.Close()
+		}
+		cs = nil
+		m.Unlock()
+	}()
+
+	// Accept
+	go func() {
+		for {
+			// Accept
+			c, err := l.Accept()
+			if err != nil {
+				if ctx.Err() == nil {
+					astilog.Error(errors.Wrapf(err, "astibob: accepting on %s failed", path))
+				}
+				return
+			}
+
+			// Store connection
+			m.Lock()
+			if cs == nil {
+				m.Unlock()
+				c.Close()
+				return
+			}
+			cs[c] = true
+			m.Unlock()
+
+			// Handle
+			go func() {
+				fn(c)
+				m.Lock()
+				delete(cs, c)
+				m.Unlock()
+			}()
+		}
+	}()
+	return
+}
+
+// DialIndex implements the Transport interface
+func (t *UnixTransport) DialIndex(ctx context.Context, hs TransportHandlers) {
+	for {
+		// Context is done
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Dial
+		nc, err := net.Dial("unix", t.indexPath())
+		if err != nil {
+			astilog.Error(errors.Wrapf(err, "astibob: dialing %s failed", t.indexPath()))
+			sleepTransportRetry(ctx)
+			continue
+		}
+		c := newUnixTransportConn(nc)
+
+		// Close connection when the context is done
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				c.Close()
+			case <-done:
+			}
+		}()
+
+		// Open
+		if err = hs.open(c); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: opening connection failed"))
+		}
+
+		// Read
+		if err = readUnixTransportPayloads(nc, func(contentType string, p []byte) {
+			if err := hs.message(c, contentType, p); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: handling index message failed"))
+			}
+		}); err != nil && err != io.EOF && ctx.Err() == nil {
+			astilog.Error(errors.Wrap(err, "astibob: reading index connection failed"))
+		}
+
+		// Close
+		close(done)
+		c.Close()
+		hs.close(c)
+
+		// Wait before reconnecting
+		sleepTransportRetry(ctx)
+	}
+}
+
+// ListenIndex implements the Transport interface
+func (t *UnixTransport) ListenIndex(ctx context.Context, hs TransportHandlers) error {
+	return listenUnix(ctx, t.indexPath(), func(nc net.Conn) {
+		// Create connection
+		c := newUnixTransportConn(nc)
+
+		// Open
+		if err := hs.open(c); err != nil {
+			astilog.Error(errors.Wrap(err, "astibob: opening connection failed"))
+			c.Close()
+			return
+		}
+
+		// Read
+		if err := readUnixTransportPayloads(nc, func(contentType string, p []byte) {
+			if err := hs.message(c, contentType, p); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: handling worker message failed"))
+			}
+		}); err != nil && err != io.EOF && ctx.Err() == nil {
+			astilog.Error(errors.Wrap(err, "astibob: reading worker connection failed"))
+		}
+
+		// Close
+		c.Close()
+		hs.close(c)
+	})
+}
+
+// ListenWorker implements the Transport interface
+func (t *UnixTransport) ListenWorker(ctx context.Context, name string, h TransportHandler) error {
+	return listenUnix(ctx, t.workerPath(name), func(c net.Conn) {
+		// Read
+		if err := readUnixTransportPayloads(c, func(contentType string, p []byte) {
+			if err := h(contentType, p); err != nil {
+				astilog.Error(errors.Wrap(err, "astibob: handling worker message failed"))
+			}
+		}); err != nil && err != io.EOF && ctx.Err() == nil {
+			astilog.Error(errors.Wrap(err, "astibob: reading worker connection failed"))
+		}
+
+		// Close
+		c.Close()
+	})
+}
+
+// SendToWorker implements the Transport interface. Connections to other workers are kept open between messages and
+// each of them is locked separately so that a worker that doesn't read its messages doesn't block the others.
+func (t *UnixTransport) SendToWorker(ctx context.Context, name, addr, contentType string, p []byte) (err error) {
+	// Get connection
+	t.m.Lock()
+	c, ok := t.cs[name]
+	if !ok {
+		c = newUnixTransportConn(nil)
+		t.cs[name] = c
+	}
+	t.m.Unlock()
+
+	// Lock
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	// The connection may have been closed by the other worker since the previous message, in which case we retry
+	// once with a new connection
+	for idx := 0; idx < 2; idx++ {
+		// Context is done
+		if ctx.Err() != nil {
+			err = errors.Wrapf(ctx.Err(), "astibob: sending to worker %s failed", name)
+			return
+		}
+
+		// Dial
+		if c.c == nil {
+			var d net.Dialer
+			if c.c, err = d.DialContext(ctx, "unix", t.workerPath(name)); err != nil {
+				err = errors.Wrapf(err, "astibob: dialing %s failed", t.workerPath(name))
+				return
+			}
+		}
+
+		// Write
+		if err = c.write(ctx, contentType, p); err == nil {
+			return
+		}
+
+		// Reset connection
+		c.c.Close()
+		c.c = nil
+	}
+	err = errors.Wrapf(err, "astibob: sending to worker %s failed", name)
+	return
+}
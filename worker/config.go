@@ -0,0 +1,248 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// ConfigOptions are the options of the configs of runnables implementing astibob.Configurable
+type ConfigOptions struct {
+	Dir string `toml:"dir"` // If set, configs are stored in this directory and survive restarts
+}
+
+func (w *Worker) configPath(runnable string) string {
+	return filepath.Join(w.o.Config.Dir, url.PathEscape(w.name), url.PathEscape(runnable)+".json")
+}
+
+// Persisted properties override the config the runnable has been created with
+func (w *Worker) loadRunnableConfig(name string, c astibob.Configurable) (err error) {
+	// No dir
+	if w.o.Config.Dir == "" {
+		return
+	}
+
+	// Read
+	var b []byte
+	if b, err = ioutil.ReadFile(w.configPath(name)); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+			return
+		}
+		err = errors.Wrapf(err, "worker: reading %s failed", w.configPath(name))
+		return
+	}
+
+	// Apply
+	if _, err = w.applyRunnableConfig(c, b); err != nil {
+		err = errors.Wrap(err, "worker: applying config failed")
+		return
+	}
+
+	// Log
+	astilog.Infof("worker: loaded config of runnable %s from %s", name, w.configPath(name))
+	return
+}
+
+func (w *Worker) saveRunnableConfig(name string, b []byte) (err error) {
+	// No dir
+	if w.o.Config.Dir == "" {
+		return
+	}
+
+	// Create dir
+	p := w.configPath(name)
+	if err = os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		err = errors.Wrapf(err, "worker: mkdirall %s failed", filepath.Dir(p))
+		return
+	}
+
+	// Write to a temporary file first so that a crash doesn't leave a partial config behind
+	if err = ioutil.WriteFile(p+".tmp", b, 0644); err != nil {
+		err = errors.Wrapf(err, "worker: writing %s failed", p+".tmp")
+		return
+	}
+
+	// Rename
+	if err = os.Rename(p+".tmp", p); err != nil {
+		err = errors.Wrapf(err, "worker: renaming %s failed", p+".tmp")
+		return
+	}
+	return
+}
+
+// Merges the update into the current config, validates the result against the schema and applies it. It returns the
+// config once applied.
+func (w *Worker) applyRunnableConfig(c astibob.Configurable, update json.RawMessage) (o json.RawMessage, err error) {
+	// Marshal current config
+	var b []byte
+	if b, err = json.Marshal(c.Config()); err != nil {
+		err = errors.Wrap(err, "worker: marshaling config failed")
+		return
+	}
+
+	// Merge
+	if b, err = astibob.MergeConfig(b, update); err != nil {
+		err = astibob.NewError(astibob.InvalidConfigErrorCode, errors.Wrap(err, "worker: merging config failed").Error())
+		return
+	}
+
+	// Validate
+	if err = c.ConfigSchema().Validate(b); err != nil {
+		err = astibob.NewError(astibob.InvalidConfigErrorCode, errors.Wrap(err, "worker: validating config failed").Error())
+		return
+	}
+
+	// Set
+	if err = c.SetConfig(b); err != nil {
+		err = errors.Wrap(err, "worker: setting config failed")
+		return
+	}
+
+	// Marshal new config
+	if o, err = json.Marshal(c.Config()); err != nil {
+		err = errors.Wrap(err, "worker: marshaling config failed")
+		return
+	}
+	return
+}
+
+// UpdateRunnableConfig updates the properties of the config of a runnable implementing astibob.Configurable, persists
+// it and lets the index, the UI and the other workers know
+func (w *Worker) UpdateRunnableConfig(name string, update json.RawMessage) (o json.RawMessage, err error) {
+	// Fetch runnable
+	w.mr.Lock()
+	r, ok := w.rs[name]
+	w.mr.Unlock()
+
+	// No runnable
+	if !ok {
+		err = fmt.Errorf("worker: no %s runnable", name)
+		return
+	}
+
+	// Runnable is not configurable
+	c, ok := r.(astibob.Configurable)
+	if !ok {
+		err = astibob.NewError(astibob.InvalidConfigErrorCode, fmt.Sprintf("worker: runnable %s is not configurable", name))
+		return
+	}
+
+	// Lock
+	// Updates are serialized so that the persisted config is the last one applied
+	w.mf.Lock()
+	defer w.mf.Unlock()
+
+	// Marshal previous config
+	var p []byte
+	if p, err = json.Marshal(c.Config()); err != nil {
+		err = errors.Wrap(err, "worker: marshaling previous config failed")
+		return
+	}
+
+	// Apply
+	if o, err = w.applyRunnableConfig(c, update); err != nil {
+		err = errors.Wrap(err, "worker: applying config failed")
+		return
+	}
+
+	// Save
+	if err = w.saveRunnableConfig(name, o); err != nil {
+		// The previous config is applied again so that the active config is the persisted one
+		if rerr := c.SetConfig(p); rerr != nil {
+			astilog.Error(errors.Wrapf(rerr, "worker: rolling back config of runnable %s failed", name))
+		}
+		err = errors.Wrap(err, "worker: saving config failed")
+		return
+	}
+
+	// Log
+	astilog.Infof("worker: updated config of runnable %s", name)
+
+	// Dispatch
+	w.d.Dispatch(astibob.NewRunnableConfigUpdatedMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
+		astibob.UIIdentifierType:     true,
+		astibob.WorkerIdentifierType: true,
+	}}, o))
+	return
+}
+
+// Config updates are handled by the worker on behalf of the runnable, the other messages are handled by the runnable
+func (w *Worker) runnableHandler(r astibob.Runnable) astibob.MessageHandler {
+	return func(m *astibob.Message) error {
+		if m.Name == astibob.RunnableConfigUpdateMessage {
+			return w.updateRunnableConfigFromMessage(r.Metadata().Name, m)
+		}
+		return r.OnMessage(m)
+	}
+}
+
+func (w *Worker) updateRunnableConfigFromMessage(name string, m *astibob.Message) (err error) {
+	// Update
+	var o json.RawMessage
+	o, err = w.UpdateRunnableConfig(name, m.Payload)
+
+	// We need to send a done message
+	if m.ID > 0 {
+		// Create done
+		d := astibob.RunnableDone{
+			ID:      m.ID,
+			Payload: o,
+			Success: err == nil,
+		}
+
+		// Add error
+		if err != nil {
+			if v, ok := errors.Cause(err).(*astibob.Error); ok {
+				d.Error = v
+			} else {
+				d.Error = &astibob.Error{Message: err.Error()}
+			}
+		}
+
+		// Create message
+		dm, derr := astibob.NewRunnableDoneMessage(m.From.Clone(), d)
+		if derr != nil {
+			astilog.Error(errors.Wrap(derr, "worker: creating runnable done message failed"))
+			return
+		}
+		dm.From = *w.runnableIdentifier(name)
+
+		// Dispatch
+		w.d.Dispatch(dm)
+	}
+
+	// Error
+	if err != nil {
+		err = errors.Wrapf(err, "worker: updating config of runnable %s failed", name)
+		return
+	}
+	return
+}
+
+// Returns nil if the runnable is not configurable
+func runnableConfig(r astibob.Runnable) (rc *astibob.RunnableConfig) {
+	// Runnable is not configurable
+	c, ok := r.(astibob.Configurable)
+	if !ok {
+		return
+	}
+
+	// Marshal
+	b, err := json.Marshal(c.Config())
+	if err != nil {
+		astilog.Error(errors.Wrapf(err, "worker: marshaling config of runnable %s failed", r.Metadata().Name))
+		return
+	}
+	return &astibob.RunnableConfig{
+		Schema: c.ConfigSchema(),
+		Values: b,
+	}
+}
@@ -0,0 +1,15 @@
+package worker
+
+import (
+	"github.com/asticode/go-astibob"
+)
+
+// DeadLetters returns the messages whose handler returned an error or panicked
+func (w *Worker) DeadLetters() []astibob.DeadLetter {
+	return w.d.DeadLetters()
+}
+
+// ReplayDeadLetter hands the message of a dead letter over to its handler again
+func (w *Worker) ReplayDeadLetter(id int) error {
+	return w.d.ReplayDeadLetter(id)
+}
@@ -0,0 +1,223 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Period at which the outbox is checked for messages to retry
+const outboxRetryPeriod = 250 * time.Millisecond
+
+func (w *Worker) addOutbox() {
+	// Create outbox
+	var err error
+	if w.ob, err = astibob.NewOutbox(w.o.Outbox); err != nil {
+		// Messages are still retried as long as the worker is running
+		astilog.Error(errors.Wrap(err, "worker: creating outbox failed, falling back to memory"))
+		w.ob, _ = astibob.NewOutbox(astibob.OutboxOptions{
+			MaxEntries:     w.o.Outbox.MaxEntries,
+			MaxRetryPeriod: w.o.Outbox.MaxRetryPeriod,
+			MinRetryPeriod: w.o.Outbox.MinRetryPeriod,
+		})
+	}
+
+	// Collect metrics
+	w.m.Collect(w.collectOutboxMetrics)
+
+	// Retry
+	t := w.w.NewTask()
+	go func() {
+		defer t.Done()
+		w.retryOutbox(w.w.Context())
+	}()
+}
+
+func (w *Worker) collectOutboxMetrics() {
+	// Create metric
+	m := w.m.Gauge(astibob.WorkerOutboxLengthMetric, "Number of messages waiting to be acknowledged by other workers", "worker")
+
+	// Reset
+	m.Reset()
+
+	// Loop through entries
+	ls := make(map[string]float64)
+	for _, e := range w.ob.Entries() {
+		ls[e.Worker]++
+	}
+	for n, l := range ls {
+		m.Set(l, n)
+	}
+}
+
+func (w *Worker) retryOutbox(ctx context.Context) {
+	t := time.NewTicker(outboxRetryPeriod)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-t.C:
+			// Get due entries
+			es, ees := w.ob.Due(n)
+
+			// Loop through expired entries
+			for _, e := range ees {
+				astilog.Debugf("worker: message %s to worker %s has expired before being acknowledged", e.Message.Name, e.Worker)
+				w.m.Counter(astibob.WorkerOutboxExpiredMetric, "Number of messages that expired before being acknowledged by other workers", "worker").Add(1, e.Worker)
+			}
+
+			// Group due entries by worker
+			ws := make(map[string][]astibob.OutboxEntry)
+			for _, e := range es {
+				ws[e.Worker] = append(ws[e.Worker], e)
+			}
+
+			// Workers are retried concurrently so that an unresponsive worker doesn't delay the others
+			wg := &sync.WaitGroup{}
+			for n, es := range ws {
+				// Get worker
+				w.mw.Lock()
+				mw, ok := w.ws[n]
+				w.mw.Unlock()
+
+				// Worker is not registered, we'll retry later
+				if !ok {
+					continue
+				}
+
+				// Retry
+				wg.Add(1)
+				go func(mw *worker, es []astibob.OutboxEntry) {
+					defer wg.Done()
+					w.retryWorker(mw, es)
+				}(mw, es)
+			}
+			wg.Wait()
+		}
+	}
+}
+
+// Entries are sent in order and the remaining ones are skipped as soon as one fails since they would most likely fail
+// as well
+func (w *Worker) retryWorker(mw *worker, es []astibob.OutboxEntry) {
+	for _, e := range es {
+		w.m.Counter(astibob.WorkerDeliveryRetriesMetric, "Number of messages sent again to other workers", "worker").Add(1, mw.name)
+		if err := w.sendToWorker(e.Message, mw); err != nil {
+			astilog.Debug(errors.Wrapf(err, "worker: retrying message %s to worker %s failed", e.Message.Name, mw.name))
+			return
+		}
+	}
+}
+
+// Keys must be unique across restarts since receivers remember them
+func (w *Worker) deliveryKey() string {
+	w.mi.Lock()
+	defer w.mi.Unlock()
+	w.id++
+	return fmt.Sprintf("%s-%d-%d", w.name, time.Now().UnixNano(), w.id)
+}
+
+// The message is kept in the outbox until the worker acknowledges it
+func (w *Worker) sendMessageAtLeastOnce(m *astibob.Message, worker string) (err error) {
+	// Add to outbox
+	if err = w.ob.Add(worker, m); err != nil {
+		err = errors.Wrap(err, "worker: adding message to outbox failed")
+		return
+	}
+
+	// Get worker
+	w.mw.Lock()
+	mw, ok := w.ws[worker]
+	w.mw.Unlock()
+
+	// Worker is not registered, the message will be sent once it is
+	if !ok {
+		return
+	}
+
+	// Send
+	// We don't return the error since the message will be retried
+	if err = w.sendToWorker(m, mw); err != nil {
+		astilog.Debug(errors.Wrapf(err, "worker: sending message %s to worker %s failed, it will be retried", m.Name, worker))
+		err = nil
+	}
+	return
+}
+
+// Messages delivered at least once are acknowledged once all their handlers have succeeded and are handled only once
+func (w *Worker) handleMessageAtLeastOnce(m *astibob.Message) {
+	// Message has already been handled
+	// It's acknowledged again since the previous ack may have been lost
+	if w.dd.Handled(m.From.WorkerName(), m.Delivery.Key) {
+		astilog.Debugf("worker: message %s with key %s has already been handled", m.Name, m.Delivery.Key)
+		w.acknowledgeMessage(m)
+		return
+	}
+
+	// Message is being handled
+	// It will be sent again if it's not acknowledged
+	if !w.dd.Handle(m.From.WorkerName(), m.Delivery.Key) {
+		astilog.Debugf("worker: message %s with key %s is being handled", m.Name, m.Delivery.Key)
+		return
+	}
+
+	// Dispatch
+	w.d.DispatchWithCallback(m, func(err error) {
+		// Update deduplicator
+		w.dd.Done(err, m.From.WorkerName(), m.Delivery.Key)
+
+		// Message has not been handled, it will be sent again
+		if err != nil {
+			astilog.Debug(errors.Wrapf(err, "worker: handling message %s with key %s failed, it won't be acknowledged", m.Name, m.Delivery.Key))
+			return
+		}
+
+		// Acknowledge
+		w.acknowledgeMessage(m)
+	})
+}
+
+func (w *Worker) acknowledgeMessage(m *astibob.Message) {
+	// Create ack message
+	am, err := astibob.NewMessageAckMessage(*astibob.NewWorkerIdentifier(w.name), astibob.NewWorkerIdentifier(m.From.WorkerName()), m.Delivery.Key)
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "worker: creating ack message failed"))
+		return
+	}
+
+	// Dispatch
+	w.d.Dispatch(am)
+}
+
+func (w *Worker) ackMessage(m *astibob.Message) (err error) {
+	// Ack has been sent by this worker
+	if m.To == nil || m.To.WorkerName() != w.name {
+		return
+	}
+
+	// Parse payload
+	var key string
+	if key, err = astibob.ParseMessageAckPayload(m); err != nil {
+		err = errors.Wrap(err, "worker: parsing ack payload failed")
+		return
+	}
+
+	// Ack
+	if err = w.ob.Ack(m.From.WorkerName(), key); err != nil {
+		err = errors.Wrap(err, "worker: acking outbox entry failed")
+		return
+	}
+	return
+}
+
+func (w *Worker) outbox(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, w.ob.Entries())
+}
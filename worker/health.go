@@ -0,0 +1,172 @@
+package worker
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+// Default health options
+const (
+	defaultHealthPeriod  = 10 * time.Second
+	defaultHealthTimeout = 5 * time.Second
+)
+
+// HealthOptions are the options of the health checks of runnables implementing astibob.HealthChecker
+type HealthOptions struct {
+	Period  time.Duration `toml:"period"`  // Defaults to 10s
+	Timeout time.Duration `toml:"timeout"` // A check taking longer is considered unhealthy, defaults to 5s
+}
+
+func (w *Worker) addHealth() {
+	// Default options
+	if w.o.Health.Period <= 0 {
+		w.o.Health.Period = defaultHealthPeriod
+	}
+	if w.o.Health.Timeout <= 0 {
+		w.o.Health.Timeout = defaultHealthTimeout
+	}
+
+	// Check
+	t := w.w.NewTask()
+	go func() {
+		defer t.Done()
+		w.checkHealthPeriodically(w.w.Context())
+	}()
+}
+
+func (w *Worker) checkHealthPeriodically(ctx context.Context) {
+	t := time.NewTicker(w.o.Health.Period)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			w.checkHealth(ctx)
+		}
+	}
+}
+
+func (w *Worker) checkHealth(ctx context.Context) {
+	// Get running health checkers
+	hcs := make(map[string]astibob.HealthChecker)
+	w.mr.Lock()
+	for n, r := range w.rs {
+		if hc, ok := r.(astibob.HealthChecker); ok && w.runnableStatus(n) == astibob.RunningStatus {
+			hcs[n] = hc
+		}
+	}
+	w.mr.Unlock()
+
+	// Loop through health checkers
+	for n, hc := range hcs {
+		// Check
+		h := checkHealth(ctx, hc, w.o.Health.Timeout)
+
+		// Lock
+		w.mr.Lock()
+
+		// Runnable has stopped in the meantime
+		if w.runnableStatus(n) != astibob.RunningStatus {
+			w.mr.Unlock()
+			continue
+		}
+
+		// Store health
+		p, ok := w.hs[n]
+		w.hs[n] = h
+
+		// Unlock
+		w.mr.Unlock()
+
+		// Health hasn't changed
+		if ok && p.Status == h.Status && p.Message == h.Message {
+			continue
+		}
+
+		// Log
+		if h.Healthy() {
+			astilog.Infof("worker: runnable %s is healthy", n)
+		} else {
+			astilog.Warnf("worker: runnable %s is %s: %s", n, h.Status, h.Message)
+		}
+
+		// Create message
+		m, err := astibob.NewRunnableHealthMessage(*w.runnableIdentifier(n), &astibob.Identifier{Types: map[string]bool{
+			astibob.UIIdentifierType:     true,
+			astibob.WorkerIdentifierType: true,
+		}}, h)
+		if err != nil {
+			astilog.Error(errors.Wrap(err, "worker: creating health message failed"))
+			continue
+		}
+
+		// Dispatch
+		w.d.Dispatch(m)
+	}
+}
+
+// A health check that doesn't return in time doesn't block the other ones
+func checkHealth(ctx context.Context, hc astibob.HealthChecker, timeout time.Duration) (h astibob.Health) {
+	// Create context
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Check
+	c := make(chan astibob.Health, 1)
+	go func() { c <- hc.Health(ctx) }()
+
+	// Wait
+	select {
+	case h = <-c:
+	case <-ctx.Done():
+		h = astibob.Health{
+			Message: "health check timed out",
+			Status:  astibob.UnhealthyHealthStatus,
+		}
+	}
+
+	// Default values
+	if h.CheckedAt.IsZero() {
+		h.CheckedAt = time.Now()
+	}
+	if h.Status == "" {
+		h.Status = astibob.HealthyHealthStatus
+	}
+	return
+}
+
+// Health returns the health of the running runnables implementing astibob.HealthChecker
+func (w *Worker) Health() (h astibob.WorkerHealth) {
+	// Lock
+	w.mr.Lock()
+	defer w.mr.Unlock()
+
+	// Create health
+	h = astibob.WorkerHealth{
+		Name:      w.name,
+		Runnables: make(map[string]astibob.Health),
+	}
+
+	// Loop through runnables
+	var ss []string
+	for n, rh := range w.hs {
+		h.Runnables[n] = rh
+		ss = append(ss, rh.Status)
+	}
+
+	// Get status
+	h.Status = astibob.WorstHealthStatus(ss...)
+	return
+}
+
+func (w *Worker) health(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	h := w.Health()
+	astibob.WriteHTTPHealth(rw, h.Status, h)
+}
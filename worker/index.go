@@ -1,45 +1,91 @@
 package worker
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 	"sort"
 
 	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
-	astiworker "github.com/asticode/go-astitools/worker"
-	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 )
 
 // Register registers the worker to the index
 func (w *Worker) RegisterToIndex() {
-	// Create headers
-	h := make(http.Header)
-	if w.o.Index.Password != "" && w.o.Index.Username != "" {
-		h.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(w.o.Index.Username+":"+w.o.Index.Password)))
-	}
+	// Create task
+	t := w.w.NewTask()
 
 	// Dial
-	w.w.Dial(astiworker.DialOptions{
-		Addr:   "ws://" + w.o.Index.Addr + "/websockets/worker",
-		Client: w.cw,
-		Header: h,
-		OnDial: w.sendRegister,
-		OnReadError: func(err error) {
-			if v, ok := errors.Cause(err).(*websocket.CloseError); ok && v.Code == websocket.CloseNormalClosure {
-				astilog.Info("worker: worker has disconnected from index")
-			} else {
-				astilog.Error(errors.Wrap(err, "worker: reading websocket failed"))
-			}
-		},
-	})
+	go func() {
+		defer t.Done()
+		w.tr.DialIndex(w.w.Context(), astibob.TransportHandlers{
+			OnClose: w.onIndexClose,
+			OnMessage: func(_ astibob.TransportConn, contentType string, p []byte) error {
+				return w.handleIndexMessage(contentType, p)
+			},
+			OnOpen: w.onIndexDial,
+		})
+	}()
+}
+
+func (w *Worker) onIndexDial(c astibob.TransportConn) error {
+	// Update dials count and connection
+	w.mc.Lock()
+	w.di++
+	di := w.di
+	w.ic = c
+	w.mc.Unlock()
+
+	// Update metrics
+	if di > 1 {
+		w.m.Counter(astibob.WebsocketReconnectsMetric, "Number of times the websocket has been reconnected", "peer").Add(1, "index")
+	}
+
+	// Send register
+	return w.sendRegister()
 }
 
 func (w *Worker) sendRegister() (err error) {
+	// The codec is negotiated during registration, until then only JSON is understood by the index
+	w.setIndexCodec(astibob.DefaultCodec())
+
+	// Create register message
+	var m *astibob.Message
+	if m, err = astibob.NewWorkerRegisterMessage(*w.workerIdentifier(), &astibob.Identifier{
+		Type: astibob.IndexIdentifierType,
+	}, w.toMessage()); err != nil {
+		err = errors.Wrap(err, "worker: creating register message failed")
+		return
+	}
+
+	// Dispatch
+	w.d.Dispatch(m)
+	return
+}
+
+// Lets the index know the runnables have changed, which is only needed once the worker has registered since the
+// register message contains the runnables as well
+func (w *Worker) sendUpdate() (err error) {
+	// Not connected to index
+	if w.indexConn() == nil {
+		return
+	}
+
+	// Create update message
+	var m *astibob.Message
+	if m, err = astibob.NewWorkerUpdateMessage(*w.workerIdentifier(), &astibob.Identifier{
+		Type: astibob.IndexIdentifierType,
+	}, w.toMessage()); err != nil {
+		err = errors.Wrap(err, "worker: creating update message failed")
+		return
+	}
+
+	// Dispatch
+	w.d.Dispatch(m)
+	return
+}
+
+func (w *Worker) toMessage() astibob.Worker {
 	// Get runnable keys
 	w.mr.Lock()
 	var ks []string
@@ -58,8 +104,19 @@ func (w *Worker) sendRegister() (err error) {
 
 		// Create runnable message
 		rm := astibob.RunnableMessage{
+			Config:   runnableConfig(r),
 			Metadata: r.Metadata(),
-			Status:   r.Status(),
+			Status:   w.runnableStatus(k),
+		}
+
+		// Add health
+		if h, ok := w.hs[k]; ok {
+			rm.Health = &h
+		}
+
+		// Add last transition
+		if t, ok := w.ts[k]; ok {
+			rm.Transitions = []astibob.RunnableTransition{t}
 		}
 
 		// Add web homepage
@@ -71,25 +128,13 @@ func (w *Worker) sendRegister() (err error) {
 		rs = append(rs, rm)
 	}
 	w.mr.Unlock()
-
-	// Create register message
-	var m *astibob.Message
-	if m, err = astibob.NewWorkerRegisterMessage(*w.workerIdentifier(), &astibob.Identifier{
-		Type: astibob.IndexIdentifierType,
-	}, astibob.Worker{
+	return astibob.Worker{
 		Addr:      "http://" + w.o.Server.Addr,
+		Codecs:    astibob.CodecNames(),
 		Name:      w.name,
 		Runnables: rs,
-	}); err != nil {
-		err = errors.Wrap(err, "worker: creating register message failed")
-		return
 	}
-
-	// Dispatch
-	w.d.Dispatch(m)
-	return
 }
-
 func (w *Worker) finishRegistration(m *astibob.Message) (err error) {
 	// Parse payload
 	var wl astibob.WelcomeWorker
@@ -98,6 +143,13 @@ func (w *Worker) finishRegistration(m *astibob.Message) (err error) {
 		return
 	}
 
+	// Update index codec
+	c, ok := astibob.CodecByName(wl.Codec)
+	if !ok {
+		c = astibob.DefaultCodec()
+	}
+	w.setIndexCodec(c)
+
 	// Reset and add ui message names
 	w.mu.Lock()
 	w.us = make(map[string]bool)
@@ -143,17 +195,27 @@ func (w *Worker) finishRegistration(m *astibob.Message) (err error) {
 	return
 }
 
-func (w *Worker) handleIndexMessage(p []byte) (err error) {
-	// Log
-	astilog.Debugf("worker: handling index message %s", p)
-
+func (w *Worker) handleIndexMessage(contentType string, p []byte) (err error) {
 	// Unmarshal
-	m := astibob.NewMessage()
-	if err = json.Unmarshal(p, m); err != nil {
+	var m *astibob.Message
+	if m, err = astibob.UnmarshalMessage(contentType, p); err != nil {
 		err = errors.Wrap(err, "worker: unmarshaling failed")
 		return
 	}
 
+	// Message has expired
+	if m.Expired() {
+		astilog.Debugf("worker: dropping expired message %s from index", m.Name)
+		w.m.MessageExpired("index")
+		return
+	}
+
+	// Log
+	astilog.Debugf("worker: handling index message %s", m.Name)
+
+	// Update metrics
+	w.m.MessageReceived("index")
+
 	// Dispatch
 	w.d.Dispatch(m)
 	return
@@ -165,14 +227,77 @@ func (w *Worker) sendMessageToIndex(m *astibob.Message) (err error) {
 		return
 	}
 
+	// Message has expired
+	if m.Expired() {
+		astilog.Debugf("worker: dropping expired message %s to index", m.Name)
+		w.m.MessageExpired("index")
+		return
+	}
+
 	// Log
 	astilog.Debugf("worker: sending %s message to index", m.Name)
 
 	// Write
-	if err = w.cw.WriteJSON(m); err != nil {
-		err = errors.Wrap(err, "worker: writing JSON message failed")
+	if err = w.writeToIndex(m); err != nil {
+		err = errors.Wrap(err, "worker: writing message to index failed")
+		return
+	}
+	return
+}
+
+func (w *Worker) onIndexClose(c astibob.TransportConn) {
+	// Lock
+	w.mc.Lock()
+	defer w.mc.Unlock()
+
+	// Reset connection
+	if w.ic == c {
+		w.ic = nil
+	}
+}
+
+func (w *Worker) indexConn() astibob.TransportConn {
+	w.mc.Lock()
+	defer w.mc.Unlock()
+	return w.ic
+}
+
+func (w *Worker) indexCodec() astibob.Codec {
+	w.mc.Lock()
+	defer w.mc.Unlock()
+	return w.ci
+}
+
+func (w *Worker) setIndexCodec(c astibob.Codec) {
+	w.mc.Lock()
+	defer w.mc.Unlock()
+	w.ci = c
+}
+
+func (w *Worker) writeToIndex(m *astibob.Message) (err error) {
+	// Marshal
+	c := w.indexCodec()
+	var b []byte
+	if b, err = c.Marshal(m); err != nil {
+		err = errors.Wrapf(err, "worker: marshaling with codec %s failed", c.Name())
+		return
+	}
+
+	// No connection
+	ic := w.indexConn()
+	if ic == nil {
+		err = errors.New("worker: not connected to index")
+		return
+	}
+
+	// Write
+	if err = ic.Write(c.ContentType(), b); err != nil {
+		err = errors.Wrap(err, "worker: writing failed")
 		return
 	}
+
+	// Update metrics
+	w.m.MessageSent("index")
 	return
 }
 
@@ -200,6 +325,30 @@ func (w *Worker) registerWorker(m *astibob.Message) (err error) {
 	return
 }
 
+func (w *Worker) updateWorker(m *astibob.Message) (err error) {
+	// Parse payload
+	var mw astibob.Worker
+	if mw, err = astibob.ParseWorkerUpdatedPayload(m); err != nil {
+		err = errors.Wrap(err, "worker: parsing updated payload failed")
+		return
+	}
+
+	// Do not process itself
+	if mw.Name == w.name {
+		return
+	}
+
+	// Replace worker
+	w.addWorker(mw)
+
+	// Runnables we listen to may have been added
+	if err = w.sendRegisterListenables(mw.Name); err != nil {
+		err = errors.Wrapf(err, "worker: sending register listenables to worker %s failed", mw.Name)
+		return
+	}
+	return
+}
+
 func (w *Worker) resetWorkers() {
 	// Lock
 	w.mw.Lock()
@@ -219,6 +368,9 @@ func (w *Worker) addWorker(m astibob.Worker) {
 
 	// Update pool
 	w.ws[nw.name] = nw
+
+	// Messages waiting for the worker can be sent right away
+	w.ob.Retry(nw.name)
 	return
 }
 
@@ -238,6 +390,9 @@ func (w *Worker) unregisterWorker(m *astibob.Message) (err error) {
 	// Delete worker
 	w.delWorker(name)
 
+	// Messages sent to the worker will never be done
+	w.failPendingMessages(name)
+
 	// Update listenables
 	w.mo.Lock()
 	for r := range w.ols {
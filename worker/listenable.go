@@ -1,29 +1,39 @@
 package worker
 
 import (
+	"sort"
+
 	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
 	"github.com/pkg/errors"
 )
 
+// Listenable's Runnable and Worker can be glob patterns as described in astibob.Match, and so can its message names
 type Listenable struct {
+	Filters    map[string]string // Filter expressions as described in astibob.Filter indexed by message name. Messages are filtered by the worker sending them.
 	Listenable astibob.Listenable
 	Runnable   string
 	Worker     string
 }
 
-func (w *Worker) RegisterListenables(ls ...Listenable) {
+// RegisterListenables registers listenables until the returned subscription is turned off
+func (w *Worker) RegisterListenables(ls ...Listenable) *astibob.Subscription {
 	// Loop through listenables
+	var ss []*astibob.Subscription
 	for _, l := range ls {
 		// Default worker
 		if l.Worker == "" {
 			l.Worker = w.name
 		}
 
+		// Parse filters
+		fs := parseListenableFilters(l)
+
 		// Add dispatcher handler
-		w.d.On(astibob.DispatchConditions{
+		ss = append(ss, w.d.OnNamed(astibob.HandlerName(l.Listenable.OnMessage), astibob.DispatchConditions{
 			From: astibob.NewRunnableIdentifier(l.Runnable, l.Worker),
 			To:   w.workerIdentifier(),
-		}, l.Listenable.OnMessage)
+		}, w.t.Handler(handlerTags(listenableHandlerTag, l.Runnable), filteredHandler(fs, l.Listenable.OnMessage))))
 
 		// Add message names
 		if ns := l.Listenable.MessageNames(); len(ns) > 0 {
@@ -32,40 +42,255 @@ func (w *Worker) RegisterListenables(ls ...Listenable) {
 
 			// Add worker key
 			if _, ok := w.ls[l.Worker]; !ok {
-				w.ls[l.Worker] = make(map[string]map[string]bool)
+				w.ls[l.Worker] = make(map[string]map[string]map[string]int)
 			}
 
 			// Add runnable key
 			if _, ok := w.ls[l.Worker][l.Runnable]; !ok {
-				w.ls[l.Worker][l.Runnable] = make(map[string]bool)
+				w.ls[l.Worker][l.Runnable] = make(map[string]map[string]int)
 			}
 
 			// Add message name keys
 			for _, n := range ns {
-				w.ls[l.Worker][l.Runnable][n] = true
+				if _, ok := w.ls[l.Worker][l.Runnable][n]; !ok {
+					w.ls[l.Worker][l.Runnable][n] = make(map[string]int)
+				}
+				w.ls[l.Worker][l.Runnable][n][filterExpression(fs, n)]++
 			}
 
 			// Unlock
 			w.ml.Unlock()
 		}
 	}
+	return astibob.NewSubscription(func() {
+		// Remove dispatcher handlers
+		for _, s := range ss {
+			s.Off()
+		}
+
+		// Unregister listenables
+		for _, l := range ls {
+			if err := w.unregisterListenable(l); err != nil {
+				astilog.Error(errors.Wrapf(err, "worker: unregistering listenable of runnable %s on worker %s failed", l.Runnable, l.Worker))
+			}
+		}
+	})
 }
 
-func (w *Worker) sendRegisterListenables(worker string) (err error) {
+// parseListenableFilters returns the valid filters indexed by message name. Messages whose filter is invalid are not
+// filtered.
+func parseListenableFilters(l Listenable) (fs map[string]*astibob.Filter) {
+	fs = make(map[string]*astibob.Filter)
+	for n, e := range l.Filters {
+		f, err := astibob.ParseFilter(e)
+		if err != nil {
+			astilog.Error(errors.Wrapf(err, "worker: parsing filter of message %s of runnable %s failed", n, l.Runnable))
+			continue
+		}
+		fs[n] = f
+	}
+	return
+}
+
+func filterExpression(fs map[string]*astibob.Filter, name string) string {
+	if f, ok := fs[name]; ok {
+		return f.String()
+	}
+	return ""
+}
+
+// Messages are filtered on reception as well since the other worker sends them as soon as they match the filters of
+// one of this worker's listenables
+func filteredHandler(fs map[string]*astibob.Filter, h astibob.MessageHandler) astibob.MessageHandler {
+	// No filters
+	if len(fs) == 0 {
+		return h
+	}
+	return func(m *astibob.Message) error {
+		// Loop through filters
+		for n, f := range fs {
+			if astibob.Match(n, m.Name) && !f.Match(m.Payload) {
+				return nil
+			}
+		}
+		return h(m)
+	}
+}
+
+func (w *Worker) unregisterListenable(l Listenable) (err error) {
+	// Default worker
+	if l.Worker == "" {
+		l.Worker = w.name
+	}
+
+	// Parse filters
+	fs := parseListenableFilters(l)
+
 	// Lock
 	w.ml.Lock()
-	defer w.ml.Unlock()
 
-	// No listenables for this worker
-	if _, ok := w.ls[worker]; !ok {
-		return
+	// Loop through message names
+	var ns, us []string
+	for _, n := range l.Listenable.MessageNames() {
+		// Decrement filter count
+		e := filterExpression(fs, n)
+		if w.ls[l.Worker][l.Runnable][n][e]--; w.ls[l.Worker][l.Runnable][n][e] > 0 {
+			continue
+		}
+		delete(w.ls[l.Worker][l.Runnable][n], e)
+
+		// Message name is still needed by other listenables but its filters have changed
+		if len(w.ls[l.Worker][l.Runnable][n]) > 0 {
+			us = append(us, n)
+			continue
+		}
+
+		// Delete message name key
+		delete(w.ls[l.Worker][l.Runnable], n)
+		ns = append(ns, n)
+	}
+
+	// Clean keys
+	if len(w.ls[l.Worker][l.Runnable]) == 0 {
+		delete(w.ls[l.Worker], l.Runnable)
+	}
+	if len(w.ls[l.Worker]) == 0 {
+		delete(w.ls, l.Worker)
+	}
+
+	// Unlock
+	w.ml.Unlock()
+
+	// Loop through workers matching the listenable worker
+	for _, worker := range w.workerNames(l.Worker) {
+		// Update filters
+		if len(us) > 0 {
+			// Get filters
+			w.ml.Lock()
+			fs := w.listenableFilters(worker)[l.Runnable]
+			w.ml.Unlock()
+
+			// Create message
+			var m *astibob.Message
+			if m, err = astibob.NewListenablesRegisterMessage(
+				*w.workerIdentifier(),
+				astibob.NewWorkerIdentifier(worker),
+				newListenables(l.Runnable, us, fs),
+			); err != nil {
+				err = errors.Wrap(err, "worker: creating register message failed")
+				return
+			}
+
+			// Dispatch
+			w.d.Dispatch(m)
+		}
+
+		// No message names to unregister
+		if len(ns) == 0 {
+			continue
+		}
+
+		// Create message
+		var m *astibob.Message
+		if m, err = astibob.NewListenablesUnregisterMessage(
+			*w.workerIdentifier(),
+			astibob.NewWorkerIdentifier(worker),
+			astibob.Listenables{
+				Names:    ns,
+				Runnable: l.Runnable,
+			},
+		); err != nil {
+			err = errors.Wrap(err, "worker: creating unregister message failed")
+			return
+		}
+
+		// Dispatch
+		w.d.Dispatch(m)
+	}
+	return
+}
+
+// Merges the filter expressions of all worker patterns matching the worker. Filter expressions are indexed by
+// runnable --> message and an empty expression means the message is not filtered. Assumes the mutex is held.
+func (w *Worker) listenableFilters(worker string) (rs map[string]map[string]map[string]bool) {
+	rs = make(map[string]map[string]map[string]bool)
+	for wp, wrs := range w.ls {
+		// Worker doesn't match
+		if !astibob.Match(wp, worker) {
+			continue
+		}
+
+		// Loop through runnables
+		for r, ns := range wrs {
+			if _, ok := rs[r]; !ok {
+				rs[r] = make(map[string]map[string]bool)
+			}
+			for n, es := range ns {
+				if _, ok := rs[r][n]; !ok {
+					rs[r][n] = make(map[string]bool)
+				}
+				for e := range es {
+					rs[r][n][e] = true
+				}
+			}
+		}
+	}
+	return
+}
+
+// A message is not filtered as soon as one of its listenables is not filtered
+func newListenables(runnable string, names []string, fs map[string]map[string]bool) (l astibob.Listenables) {
+	l = astibob.Listenables{
+		Names:    names,
+		Runnable: runnable,
+	}
+	for _, n := range names {
+		// Message is not filtered
+		if _, ok := fs[n][""]; ok || len(fs[n]) == 0 {
+			continue
+		}
+
+		// Add filters
+		if l.Filters == nil {
+			l.Filters = make(map[string][]string)
+		}
+		for e := range fs[n] {
+			l.Filters[n] = append(l.Filters[n], e)
+		}
+		sort.Strings(l.Filters[n])
+	}
+	return
+}
+
+func (w *Worker) workerNames(pattern string) (ns []string) {
+	// Current worker
+	if astibob.Match(pattern, w.name) {
+		ns = append(ns, w.name)
 	}
 
+	// Lock
+	w.mw.Lock()
+	defer w.mw.Unlock()
+
+	// Loop through workers
+	for n := range w.ws {
+		if n != w.name && astibob.Match(pattern, n) {
+			ns = append(ns, n)
+		}
+	}
+	return
+}
+
+func (w *Worker) sendRegisterListenables(worker string) (err error) {
+	// Lock
+	w.ml.Lock()
+	defer w.ml.Unlock()
+
 	// Loop through runnables
-	for r, ns := range w.ls[worker] {
+	for r, fs := range w.listenableFilters(worker) {
 		// Loop through message names
 		var p []string
-		for n := range ns {
+		for n := range fs {
 			p = append(p, n)
 		}
 
@@ -74,10 +299,7 @@ func (w *Worker) sendRegisterListenables(worker string) (err error) {
 		if m, err = astibob.NewListenablesRegisterMessage(
 			*w.workerIdentifier(),
 			astibob.NewWorkerIdentifier(worker),
-			astibob.Listenables{
-				Names:    p,
-				Runnable: r,
-			},
+			newListenables(r, p, fs),
 		); err != nil {
 			err = errors.Wrap(err, "worker: creating register message failed")
 			return
@@ -111,20 +333,75 @@ func (w *Worker) registerListenables(m *astibob.Message) (err error) {
 
 	// Add runnable key
 	if _, ok := w.ols[l.Runnable]; !ok {
-		w.ols[l.Runnable] = make(map[string]map[string]bool)
+		w.ols[l.Runnable] = make(map[string]map[string][]*astibob.Filter)
 	}
 
 	// Add worker key
 	if _, ok := w.ols[l.Runnable][worker]; !ok {
-		w.ols[l.Runnable][worker] = make(map[string]bool)
+		w.ols[l.Runnable][worker] = make(map[string][]*astibob.Filter)
 	}
 
 	// Add message name keys
 	for _, n := range l.Names {
-		w.ols[l.Runnable][worker][n] = true
+		w.ols[l.Runnable][worker][n] = parseFilters(worker, l.Runnable, n, l.Filters[n])
 	}
 
 	// Unlock
 	w.mo.Unlock()
 	return
 }
+
+// Returns nil, which means the message is not filtered, if there are no expressions or one of them is invalid, since
+// the other worker filters messages on reception anyway
+func parseFilters(worker, runnable, name string, es []string) (fs []*astibob.Filter) {
+	for _, e := range es {
+		f, err := astibob.ParseFilter(e)
+		if err != nil {
+			astilog.Error(errors.Wrapf(err, "worker: parsing filter of message %s of runnable %s requested by worker %s failed", name, runnable, worker))
+			return nil
+		}
+		fs = append(fs, f)
+	}
+	return
+}
+
+func (w *Worker) unregisterListenables(m *astibob.Message) (err error) {
+	// Get worker name
+	worker := m.From.WorkerName()
+
+	// Invalid worker name
+	if worker == "" {
+		err = errors.New("worker: invalid worker name")
+		return
+	}
+
+	// Parse payload
+	var l astibob.Listenables
+	if l, err = astibob.ParseListenablesUnregisterPayload(m); err != nil {
+		err = errors.Wrap(err, "worker: parsing unregister payload failed")
+		return
+	}
+
+	// Lock
+	w.mo.Lock()
+	defer w.mo.Unlock()
+
+	// No listenables
+	if _, ok := w.ols[l.Runnable][worker]; !ok {
+		return
+	}
+
+	// Delete message name keys
+	for _, n := range l.Names {
+		delete(w.ols[l.Runnable][worker], n)
+	}
+
+	// Clean keys
+	if len(w.ols[l.Runnable][worker]) == 0 {
+		delete(w.ols[l.Runnable], worker)
+	}
+	if len(w.ols[l.Runnable]) == 0 {
+		delete(w.ols, l.Runnable)
+	}
+	return
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/asticode/go-astibob"
+)
+
+func (w *Worker) addMetrics() {
+	// Measure handlers
+	w.d.Use(astibob.MetricsMiddleware(w.m))
+
+	// Collect
+	astibob.CollectDispatcherMetrics(w.m, w.d)
+	w.m.Collect(w.collectRunnableMetrics)
+}
+
+func (w *Worker) collectRunnableMetrics() {
+	// Create metrics
+	rm := w.m.Gauge(astibob.RunnableRunningMetric, "Whether runnables are running", "runnable")
+	um := w.m.Gauge(astibob.RunnableUptimeMetric, "Number of seconds since runnables have started", "runnable")
+
+	// Reset
+	rm.Reset()
+	um.Reset()
+
+	// Lock
+	w.mr.Lock()
+	defer w.mr.Unlock()
+
+	// Loop through runnables
+	for n := range w.rs {
+		// Running
+		var v float64
+		if w.runnableStatus(n) == astibob.RunningStatus {
+			v = 1
+		}
+		rm.Set(v, n)
+
+		// Uptime
+		if t, ok := w.st[n]; ok {
+			um.Set(time.Since(t).Seconds(), n)
+		}
+	}
+}
+
+// Metrics returns the metrics exposed through the /api/metrics route. Runnables can add their own metrics to it.
+func (w *Worker) Metrics() *astibob.Metrics {
+	return w.m
+}
@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+)
+
+type pendingMessage struct {
+	createdAt time.Time
+	deadline  time.Time
+	f         doneFunc
+	id        int
+	name      string
+	runnable  string
+	t         *time.Timer
+	worker    string
+}
+
+// PendingMessage is a message waiting for the runnable it has been sent to to be done handling it
+type PendingMessage struct {
+	CreatedAt time.Time  `json:"created_at"`
+	Deadline  *time.Time `json:"deadline,omitempty"`
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	Runnable  string     `json:"runnable"`
+	Worker    string     `json:"worker"`
+}
+
+func (w *Worker) addPendingMessage(m *astibob.Message, timeout time.Duration, f doneFunc) {
+	// Create pending message
+	p := &pendingMessage{
+		createdAt: time.Now(),
+		f:         f,
+		id:        m.ID,
+		name:      m.Name,
+		runnable:  *m.To.Name,
+		worker:    *m.To.Worker,
+	}
+
+	// Lock
+	w.md.Lock()
+	defer w.md.Unlock()
+
+	// Add timer
+	if timeout > 0 {
+		p.deadline = p.createdAt.Add(timeout)
+		p.t = time.AfterFunc(timeout, func() {
+			w.failPendingMessage(p.id, astibob.DeadlineExceededErrorCode, fmt.Sprintf("worker: runnable %s on worker %s didn't handle message %s in time", p.runnable, p.worker, p.name))
+		})
+	}
+
+	// Add pending message
+	w.ds[p.id] = p
+}
+
+func (w *Worker) delPendingMessage(id int) (p *pendingMessage, ok bool) {
+	// Lock
+	w.md.Lock()
+	defer w.md.Unlock()
+
+	// Get pending message
+	if p, ok = w.ds[id]; !ok {
+		return
+	}
+
+	// Stop timer
+	if p.t != nil {
+		p.t.Stop()
+	}
+
+	// Delete pending message
+	delete(w.ds, id)
+	return
+}
+
+func (w *Worker) failPendingMessage(id int, code, message string) {
+	// Get pending message
+	p, ok := w.delPendingMessage(id)
+	if !ok {
+		return
+	}
+
+	// On done
+	if err := p.f(nil, astibob.RunnableDone{
+		Error: astibob.NewError(code, message),
+		ID:    id,
+	}); err != nil {
+		astilog.Error(errors.Wrap(err, "worker: on done failed"))
+	}
+}
+
+func (w *Worker) failPendingMessages(worker string) {
+	// Get ids
+	var ids []int
+	w.md.Lock()
+	for id, p := range w.ds {
+		if p.worker == worker {
+			ids = append(ids, id)
+		}
+	}
+	w.md.Unlock()
+
+	// Loop through ids
+	for _, id := range ids {
+		w.failPendingMessage(id, astibob.WorkerDisconnectedErrorCode, fmt.Sprintf("worker: worker %s has disconnected", worker))
+	}
+}
+
+// PendingMessages returns the messages waiting for a runnable to be done handling them
+func (w *Worker) PendingMessages() (ps []PendingMessage) {
+	// Lock
+	w.md.Lock()
+	defer w.md.Unlock()
+
+	// Loop through pending messages
+	ps = []PendingMessage{}
+	for _, p := range w.ds {
+		// Create pending message
+		pm := PendingMessage{
+			CreatedAt: p.createdAt,
+			ID:        p.id,
+			Name:      p.name,
+			Runnable:  p.runnable,
+			Worker:    p.worker,
+		}
+
+		// Add deadline
+		if !p.deadline.IsZero() {
+			d := p.deadline
+			pm.Deadline = &d
+		}
+
+		// Append
+		ps = append(ps, pm)
+	}
+
+	// Sort
+	sort.Slice(ps, func(i, j int) bool { return ps[i].ID < ps[j].ID })
+	return
+}
+
+func (w *Worker) pendingMessages(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, w.PendingMessages())
+}
+
+func doneError(d astibob.RunnableDone) error {
+	if d.Error == nil {
+		return nil
+	}
+	return d.Error
+}
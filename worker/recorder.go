@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"io"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+func (w *Worker) addRecorder() {
+	// No path
+	if w.o.Recorder.Path == "" {
+		return
+	}
+
+	// Create recorder
+	var err error
+	if w.rc, err = astibob.NewRecorder(w.o.Recorder); err != nil {
+		astilog.Error(errors.Wrap(err, "worker: creating recorder failed"))
+		return
+	}
+
+	// Record dispatched messages
+	w.d.SetRecorder(w.rc)
+}
+
+func (w *Worker) closeRecorder() {
+	// No recorder
+	if w.rc == nil {
+		return
+	}
+
+	// Close
+	if err := w.rc.Close(); err != nil {
+		astilog.Error(errors.Wrap(err, "worker: closing recorder failed"))
+	}
+}
+
+// Replay dispatches the messages of a recording matching the options as if they had just been received
+func (w *Worker) Replay(ctx context.Context, r io.Reader, o astibob.ReplayOptions) error {
+	return astibob.Replay(ctx, r, o, func(m *astibob.Message) error {
+		w.d.Dispatch(m)
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asticode/go-astibob"
+	"github.com/pkg/errors"
+)
+
+// Request sends a message to a runnable and waits for its reply. The reply payload is the one set by the runnable
+// through astibob.Message.Reply and if the runnable returns an *astibob.Error it is returned as is. OnDone and
+// OnDoneWithError are ignored. Timeout is honored as well as ctx.
+func (w *Worker) Request(ctx context.Context, o MessageOptions) (r *astibob.Message, err error) {
+	// Create chans
+	cr := make(chan *astibob.Message, 1)
+	ce := make(chan error, 1)
+
+	// Send message
+	var m *astibob.Message
+	if m, err = w.sendMessage(o, func(dm *astibob.Message, d astibob.RunnableDone) error {
+		// Error
+		if !d.Success {
+			if err := doneError(d); err != nil {
+				ce <- err
+			} else {
+				ce <- fmt.Errorf("worker: runnable %s failed handling message %s", o.Runnable, o.Message.Name)
+			}
+			return nil
+		}
+
+		// Reply
+		cr <- &astibob.Message{
+			From:    dm.From,
+			ID:      d.ID,
+			Name:    o.Message.Name,
+			Payload: d.Payload,
+			To:      dm.To,
+		}
+		return nil
+	}); err != nil {
+		err = errors.Wrap(err, "worker: sending message failed")
+		return
+	}
+
+	// Wait
+	select {
+	case r = <-cr:
+	case err = <-ce:
+	case <-ctx.Done():
+		// Remove pending message
+		w.delPendingMessage(m.ID)
+
+		// Update error
+		err = errors.Wrapf(ctx.Err(), "worker: waiting for reply to message %s failed", o.Message.Name)
+	}
+	return
+}
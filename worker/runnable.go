@@ -1,8 +1,11 @@
 package worker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"runtime/debug"
+	"time"
 
 	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
@@ -11,42 +14,184 @@ import (
 
 type Runnable struct {
 	AutoStart bool
+	Restart   RestartOptions // Defines whether the runnable is restarted when it stops on its own
 	Runnable  astibob.Runnable
 }
 
+// runnableExecution is a runnable's Start method executed by the worker
+type runnableExecution struct {
+	cancel context.CancelFunc
+	done   chan struct{} // Closed once Start has returned
+}
+
+// RegisterRunnables registers runnables, which can be done at any time. If the worker has already registered to the
+// index, the index and the other workers are notified.
 func (w *Worker) RegisterRunnables(rs ...Runnable) {
 	// Loop through runnables
+	var registered bool
+	var ss []string
 	for _, r := range rs {
-		// Add to pool
+		// Lock
 		w.mr.Lock()
+
+		// Runnable already exists
+		if _, ok := w.rs[r.Runnable.Metadata().Name]; ok {
+			w.mr.Unlock()
+			astilog.Errorf("worker: runnable %s is already registered", r.Runnable.Metadata().Name)
+			continue
+		}
+
+		// Add to pool
 		w.rs[r.Runnable.Metadata().Name] = r.Runnable
+		w.sv[r.Runnable.Metadata().Name] = newSupervisor(r.Restart)
+
+		// Add router
+		if o, ok := r.Runnable.(astibob.Operatable); ok {
+			w.rr[r.Runnable.Metadata().Name] = newRunnableRouter(o)
+		}
+
+		// Unlock
 		w.mr.Unlock()
+		registered = true
 
 		// Set dispatch func
 		r.Runnable.SetDispatchFunc(w.dispatchFunc(r.Runnable.Metadata().Name))
 
+		// Set metrics
+		r.Runnable.SetMetrics(w.m)
+
 		// Set root context
 		r.Runnable.SetRootCtx(w.w.Context())
 
+		// Set started func
+		r.Runnable.SetStartedFunc(w.startedFunc(r.Runnable))
+
+		// Set store
+		r.Runnable.SetStore(w.runnableStore(r.Runnable.Metadata().Name))
+
 		// Set task func
 		r.Runnable.SetTaskFunc(w.w.NewTask)
 
+		// Load config
+		if c, ok := r.Runnable.(astibob.Configurable); ok {
+			if err := w.loadRunnableConfig(r.Runnable.Metadata().Name, c); err != nil {
+				astilog.Error(errors.Wrapf(err, "worker: loading config of runnable %s failed", r.Runnable.Metadata().Name))
+			}
+		}
+
+		// Set concurrency
+		if c, ok := r.Runnable.(astibob.Concurrent); ok {
+			w.d.SetKeyConcurrency(astibob.RunnableQueueKey(w.name, r.Runnable.Metadata().Name), c.Concurrency(), c.OrderingKey)
+		}
+
 		// Add dispatch handlers
-		w.d.On(astibob.DispatchConditions{To: w.runnableIdentifier(r.Runnable.Metadata().Name)}, r.Runnable.OnMessage)
+		h := w.d.OnNamed(astibob.HandlerName(r.Runnable.OnMessage), astibob.DispatchConditions{To: w.runnableIdentifier(r.Runnable.Metadata().Name)}, w.t.Handler(handlerTags(runnableHandlerTag, r.Runnable.Metadata().Name), w.runnableHandler(r.Runnable)))
+		w.mr.Lock()
+		w.rh[r.Runnable.Metadata().Name] = h
+		w.mr.Unlock()
 
 		// Log
 		astilog.Infof("worker: registered runnable %s", r.Runnable.Metadata().Name)
 
 		// Auto start
 		if r.AutoStart {
-			// Start runnable
-			if err := w.startRunnable(r.Runnable.Metadata().Name); err != nil {
-				astilog.Error(errors.Wrapf(err, "worker: starting runnable %s failed", r.Runnable.Metadata().Name))
-			}
+			ss = append(ss, r.Runnable.Metadata().Name)
+		}
+	}
+
+	// Send update
+	if registered {
+		if err := w.sendUpdate(); err != nil {
+			astilog.Error(errors.Wrap(err, "worker: sending update failed"))
+		}
+	}
+
+	// Runnables are started once the index knows about them
+	for _, name := range ss {
+		if err := w.startRunnable(name); err != nil {
+			astilog.Error(errors.Wrapf(err, "worker: starting runnable %s failed", name))
 		}
 	}
 }
 
+// UnregisterRunnable stops the runnable if needed and removes it, which can be done at any time but not from the
+// runnable itself. It waits for the runnable's Start method to return. Its routes and handlers are removed, and the
+// index and the other workers are notified.
+func (w *Worker) UnregisterRunnable(name string) (err error) {
+	// Stop supervising, which cancels a pending restart as well
+	w.unsuperviseRunnable(name)
+
+	// Lock
+	w.mr.Lock()
+
+	// Fetch runnable
+	r, ok := w.rs[name]
+
+	// No runnable
+	if !ok {
+		w.mr.Unlock()
+		err = fmt.Errorf("worker: no %s runnable", name)
+		return
+	}
+
+	// Get status, execution and handler
+	s := w.runnableStatus(name)
+	e := w.re[name]
+	h := w.rh[name]
+
+	// Remove from pool
+	delete(w.hs, name)
+	delete(w.re, name)
+	delete(w.rh, name)
+	delete(w.rr, name)
+	delete(w.rs, name)
+	delete(w.st, name)
+	delete(w.sv, name)
+	delete(w.ts, name)
+
+	// Unlock
+	w.mr.Unlock()
+
+	// Remove dispatch handlers
+	if h != nil {
+		h.Off()
+	}
+
+	// Reset concurrency
+	if _, ok := r.(astibob.Concurrent); ok {
+		w.d.SetKeyConcurrency(astibob.RunnableQueueKey(w.name, name), 0, nil)
+	}
+
+	// Remove other workers listenables
+	w.mo.Lock()
+	delete(w.ols, name)
+	w.mo.Unlock()
+
+	// Stop runnable
+	// The execution is cancelled as well in case the runnable is about to be started
+	if e != nil {
+		e.cancel()
+	}
+	if s == astibob.RunningStatus || s == astibob.StartingStatus {
+		r.Stop()
+	}
+
+	// Wait for the runnable to exit so that it doesn't dispatch messages anymore
+	if e != nil {
+		<-e.done
+	}
+
+	// Log
+	astilog.Infof("worker: unregistered runnable %s", name)
+
+	// Send update
+	if err = w.sendUpdate(); err != nil {
+		err = errors.Wrap(err, "worker: sending update failed")
+		return
+	}
+	return
+}
+
 func (w *Worker) dispatchFunc(name string) astibob.DispatchFunc {
 	return func(m *astibob.Message) {
 		// Set from
@@ -82,21 +227,33 @@ func (w *Worker) cloneMessageForWorkers(runnable string, i *astibob.Message) (ms
 	w.mo.Lock()
 	defer w.mo.Unlock()
 
-	// Get listenables by workers
-	wls, ok := w.ols[runnable]
+	// Loop through runnable patterns
+	ws := make(map[string]bool)
+	for r, wls := range w.ols {
+		// Runnable doesn't match
+		if !astibob.Match(r, runnable) {
+			continue
+		}
 
-	// No listenables for this runnable
-	if !ok {
-		return
-	}
+		// Loop through workers
+		for n, ls := range wls {
+			// Worker already listens to this message
+			if _, ok := ws[n]; ok {
+				continue
+			}
 
-	// Loop through workers
-	for n, ls := range wls {
-		// No listenable for this worker
-		if _, ok := ls[i.Name]; !ok {
-			continue
+			// Loop through message names
+			for l, fs := range ls {
+				if astibob.Match(l, i.Name) && matchFilters(fs, i.Payload) {
+					ws[n] = true
+					break
+				}
+			}
 		}
+	}
 
+	// Loop through workers
+	for n := range ws {
 		// Append
 		m := i.Clone()
 		m.To = astibob.NewWorkerIdentifier(n)
@@ -105,6 +262,22 @@ func (w *Worker) cloneMessageForWorkers(runnable string, i *astibob.Message) (ms
 	return
 }
 
+// A message matches as soon as it matches one of the filters
+func matchFilters(fs []*astibob.Filter, payload json.RawMessage) bool {
+	// No filters
+	if len(fs) == 0 {
+		return true
+	}
+
+	// Loop through filters
+	for _, f := range fs {
+		if f.Match(payload) {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *Worker) startRunnableFromMessage(m *astibob.Message) (err error) {
 	// Parse payload
 	var name string
@@ -122,69 +295,210 @@ func (w *Worker) startRunnableFromMessage(m *astibob.Message) (err error) {
 }
 
 func (w *Worker) startRunnable(name string) (err error) {
-	// Fetch runnable
+	// Lock
 	w.mr.Lock()
+
+	// Fetch runnable
 	r, ok := w.rs[name]
-	w.mr.Unlock()
 
 	// No runnable
 	if !ok {
+		w.mr.Unlock()
 		err = fmt.Errorf("worker: no %s runnable", name)
 		return
 	}
 
 	// Check status
-	if r.Status() == astibob.RunningStatus {
-		err = fmt.Errorf("worker: runnable %s is already running", name)
+	if s := w.runnableStatus(name); s != astibob.CrashedStatus && s != astibob.StoppedStatus {
+		w.mr.Unlock()
+		err = fmt.Errorf("worker: runnable %s is already %s", name, s)
 		return
 	}
 
+	// Update status
+	t := w.transitionRunnable(name, astibob.StartingStatus, nil, nil)
+
+	// Create execution
+	ctx, cancel := context.WithCancel(w.w.Context())
+	e := &runnableExecution{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	w.re[name] = e
+
+	// Unlock
+	w.mr.Unlock()
+
+	// Supervise
+	w.superviseRunnable(name)
+
 	// Log
 	astilog.Infof("worker: starting runnable %s", name)
 
-	// Create started message
-	m := astibob.NewRunnableStartedMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
-		astibob.UIIdentifierType:     true,
-		astibob.WorkerIdentifierType: true,
-	}})
-
 	// Dispatch
-	w.d.Dispatch(m)
+	w.dispatchRunnableTransition(name, t)
 
 	// Create new task
-	t := w.w.NewTask()
+	task := w.w.NewTask()
 
 	// Execute the rest in a goroutine
 	go func() {
 		// Make sure to let the worker know when the task is done
-		defer t.Done()
+		defer task.Done()
+
+		// Let the worker know when the runnable has exited
+		defer close(e.done)
+		defer cancel()
+
+		// Runnable has been unregistered in the meantime
+		w.mr.Lock()
+		if w.rs[name] != r {
+			w.mr.Unlock()
+			return
+		}
+		w.mr.Unlock()
 
 		// Start the runnable
-		if err := r.Start(w.w.Context()); err != nil && err != astibob.ErrContextCancelled {
+		// It lets the worker know once it has actually started
+		stack, err := start(ctx, r)
+		if err != nil && err != astibob.ErrContextCancelled {
 			astilog.Error(errors.Wrapf(err, "worker: starting runnable %s failed", r.Metadata().Name))
 		}
 
-		// Create message
+		// Make sure the runnable can be started again when it has stopped on its own
+		r.Stop()
+
+		// Remove start time and update status
+		w.mr.Lock()
+		if w.rs[name] != r {
+			// Runnable has been unregistered in the meantime
+			w.mr.Unlock()
+			return
+		}
+		delete(w.st, name)
+		var t astibob.RunnableTransition
 		if err == nil || err == astibob.ErrContextCancelled {
-			m = astibob.NewRunnableStoppedMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
-				astibob.UIIdentifierType:     true,
-				astibob.WorkerIdentifierType: true,
-			}})
+			t = w.transitionRunnable(name, astibob.StoppedStatus, nil, nil)
 			astilog.Infof("worker: runnable %s has stopped", name)
 		} else {
-			m = astibob.NewRunnableCrashedMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
-				astibob.UIIdentifierType:     true,
-				astibob.WorkerIdentifierType: true,
-			}})
+			t = w.transitionRunnable(name, astibob.CrashedStatus, err, stack)
 			astilog.Infof("worker: runnable %s has crashed", name)
 		}
+		w.mr.Unlock()
+
+		// Dispatch
+		w.dispatchRunnableTransition(name, t)
+
+		// Restart
+		if err == astibob.ErrContextCancelled {
+			err = nil
+		}
+		w.onRunnableExit(name, err)
+	}()
+	return
+}
+
+// Executed by the runnable once it has actually started
+func (w *Worker) startedFunc(r astibob.Runnable) astibob.StartedFunc {
+	return func() {
+		// Lock
+		name := r.Metadata().Name
+		w.mr.Lock()
+
+		// Runnable has been unregistered or stopped in the meantime
+		if w.rs[name] != r || w.runnableStatus(name) != astibob.StartingStatus {
+			w.mr.Unlock()
+			return
+		}
+
+		// Store start time and update status
+		w.st[name] = time.Now()
+		t := w.transitionRunnable(name, astibob.RunningStatus, nil, nil)
+
+		// Unlock
+		w.mr.Unlock()
 
 		// Dispatch
-		w.d.Dispatch(m)
+		w.dispatchRunnableTransition(name, t)
+	}
+}
+
+// A panicking runnable doesn't bring the process down, its stack is returned instead
+func start(ctx context.Context, r astibob.Runnable) (stack []byte, err error) {
+	// Recover
+	defer func() {
+		if v := recover(); v != nil {
+			stack = debug.Stack()
+			err = fmt.Errorf("worker: runnable panicked: %v", v)
+		}
 	}()
+
+	// Start
+	err = r.Start(ctx)
+	return
+}
+
+// Assumes the runnables mutex is held
+func (w *Worker) runnableStatus(name string) string {
+	if t, ok := w.ts[name]; ok {
+		return t.Status
+	}
+	return astibob.StoppedStatus
+}
+
+// Assumes the runnables mutex is held
+func (w *Worker) transitionRunnable(name, status string, err error, stack []byte) (t astibob.RunnableTransition) {
+	// Create transition
+	t = astibob.RunnableTransition{
+		At:     time.Now(),
+		From:   w.runnableStatus(name),
+		Stack:  string(stack),
+		Status: status,
+	}
+	if err != nil {
+		t.Error = err.Error()
+	}
+
+	// Store transition
+	w.ts[name] = t
+
+	// Health is only checked while the runnable is running
+	if status != astibob.RunningStatus {
+		delete(w.hs, name)
+	}
 	return
 }
 
+func (w *Worker) dispatchRunnableTransition(name string, t astibob.RunnableTransition) {
+	// Get message func
+	var fn func(from astibob.Identifier, to *astibob.Identifier, t astibob.RunnableTransition) (*astibob.Message, error)
+	switch t.Status {
+	case astibob.CrashedStatus:
+		fn = astibob.NewRunnableCrashedMessage
+	case astibob.RunningStatus:
+		fn = astibob.NewRunnableStartedMessage
+	case astibob.StartingStatus:
+		fn = astibob.NewRunnableStartingMessage
+	case astibob.StoppingStatus:
+		fn = astibob.NewRunnableStoppingMessage
+	default:
+		fn = astibob.NewRunnableStoppedMessage
+	}
+
+	// Create message
+	m, err := fn(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
+		astibob.UIIdentifierType:     true,
+		astibob.WorkerIdentifierType: true,
+	}}, t)
+	if err != nil {
+		astilog.Error(errors.Wrapf(err, "worker: creating %s transition message failed", t.Status))
+		return
+	}
+
+	// Dispatch
+	w.d.Dispatch(m)
+}
+
 func (w *Worker) stopRunnableFromMessage(m *astibob.Message) (err error) {
 	// Parse payload
 	var name string
@@ -213,38 +527,103 @@ func (w *Worker) stopRunnable(name string) (err error) {
 		return
 	}
 
+	// Stop supervising, which cancels a pending restart as well
+	cancelled := w.unsuperviseRunnable(name)
+
+	// Lock
+	w.mr.Lock()
+
+	// Runnable was waiting to be restarted, cancelling its restart is enough to stop it
+	if s := w.runnableStatus(name); cancelled && s != astibob.RunningStatus {
+		// Update status
+		t := w.transitionRunnable(name, astibob.StoppedStatus, nil, nil)
+
+		// Unlock
+		w.mr.Unlock()
+
+		// Log
+		astilog.Infof("worker: cancelled restart of runnable %s", name)
+
+		// Dispatch
+		w.dispatchRunnableTransition(name, t)
+		return
+	}
+
 	// Check status
-	if r.Status() == astibob.StoppedStatus {
-		err = fmt.Errorf("worker: runnable %s is already stopped", name)
+	if s := w.runnableStatus(name); s != astibob.RunningStatus {
+		w.mr.Unlock()
+		err = fmt.Errorf("worker: runnable %s is %s", name, s)
 		return
 	}
 
+	// Update status
+	t := w.transitionRunnable(name, astibob.StoppingStatus, nil, nil)
+
+	// Unlock
+	w.mr.Unlock()
+
 	// Log
 	astilog.Infof("worker: stopping runnable %s", r.Metadata().Name)
 
+	// Dispatch
+	w.dispatchRunnableTransition(name, t)
+
 	// Stop runnable
 	r.Stop()
 	return
 }
 
-
 type MessageOptions struct {
-	OnDone   OnDone
-	Message  Message
-	Runnable string
-	Worker   string
+	Delivery        *astibob.Delivery // If set with the astibob.AtLeastOnceDeliveryMode mode, the message is retried until it's acknowledged
+	OnDone          OnDone
+	OnDoneWithError OnDoneWithError // If set, OnDone is ignored
+	Message         Message
+	Parent          *astibob.Message // If set, the message belongs to its parent's trace, otherwise a new trace is created
+	Runnable        string
+	Timeout         time.Duration // If > 0, OnDone is executed with no success once reached
+	TTL             time.Duration // If > 0, the message is dropped instead of being handled once it has elapsed
+	Worker          string
 }
 
+// OnDone is executed once the runnable is done handling the message
 type OnDone func(success bool) error
 
+// OnDoneWithError is executed once the runnable is done handling the message. When it's not a success, err is an
+// *astibob.Error whose code is astibob.DeadlineExceededErrorCode or astibob.WorkerDisconnectedErrorCode if the runnable
+// didn't handle the message in time or if its worker has disconnected.
+type OnDoneWithError func(success bool, err error) error
+
+// doneFunc is executed when the runnable is done handling a message that has an ID
+type doneFunc func(m *astibob.Message, d astibob.RunnableDone) error
+
 type Message struct {
+	Binary  []byte
 	Name    string
 	Payload interface{}
 }
 
 func (w *Worker) SendMessage(o MessageOptions) (err error) {
+	// Create done func
+	var f doneFunc
+	if o.OnDoneWithError != nil {
+		f = func(_ *astibob.Message, d astibob.RunnableDone) error {
+			return o.OnDoneWithError(d.Success, doneError(d))
+		}
+	} else if o.OnDone != nil {
+		f = func(_ *astibob.Message, d astibob.RunnableDone) error { return o.OnDone(d.Success) }
+	}
+
+	// Send message
+	if _, err = w.sendMessage(o, f); err != nil {
+		err = errors.Wrap(err, "worker: sending message failed")
+		return
+	}
+	return
+}
+
+func (w *Worker) sendMessage(o MessageOptions, f doneFunc) (m *astibob.Message, err error) {
 	// Create message
-	m := astibob.NewMessage()
+	m = astibob.NewMessage()
 
 	// Default worker
 	if o.Worker == "" {
@@ -254,8 +633,27 @@ func (w *Worker) SendMessage(o MessageOptions) (err error) {
 	// Set basic info
 	m.From = *w.workerIdentifier()
 	m.To = astibob.NewRunnableIdentifier(o.Runnable, o.Worker)
+	m.Binary = o.Message.Binary
 	m.Name = o.Message.Name
 
+	// Set ttl
+	if o.TTL > 0 {
+		m.SetTTL(o.TTL)
+	}
+
+	// Set delivery
+	if o.Delivery != nil {
+		d := *o.Delivery
+		m.Delivery = &d
+	}
+
+	// Set trace
+	var pt *astibob.Trace
+	if o.Parent != nil {
+		pt = o.Parent.Trace
+	}
+	m.Trace = pt.Child()
+
 	// Marshal payload
 	if o.Message.Payload != nil {
 		if m.Payload, err = json.Marshal(o.Message.Payload); err != nil {
@@ -264,18 +662,16 @@ func (w *Worker) SendMessage(o MessageOptions) (err error) {
 		}
 	}
 
-	// On done
-	if o.OnDone != nil {
+	// Done func
+	if f != nil {
 		// Set id
 		w.mi.Lock()
 		w.id++
 		m.ID = w.id
 		w.mi.Unlock()
 
-		// Add callback
-		w.md.Lock()
-		w.ds[m.ID] = o.OnDone
-		w.md.Unlock()
+		// Add pending message
+		w.addPendingMessage(m, o.Timeout, f)
 	}
 
 	// Dispatch
@@ -284,6 +680,11 @@ func (w *Worker) SendMessage(o MessageOptions) (err error) {
 }
 
 func (w *Worker) doneMessage(m *astibob.Message) (err error) {
+	// Only process messages sent to the current worker since ids are only unique per worker
+	if m.To == nil || m.To.WorkerName() != w.name {
+		return
+	}
+
 	// Parse payload
 	var d astibob.RunnableDone
 	if d, err = astibob.ParseRunnableDonePayload(m); err != nil {
@@ -291,18 +692,16 @@ func (w *Worker) doneMessage(m *astibob.Message) (err error) {
 		return
 	}
 
-	// Get callback
-	w.md.Lock()
-	c, ok := w.ds[d.ID]
-	w.md.Unlock()
+	// Get pending message
+	p, ok := w.delPendingMessage(d.ID)
 
-	// No callback
+	// No pending message
 	if !ok {
 		return
 	}
 
 	// On done
-	if err = c(d.Success); err != nil {
+	if err = p.f(m, d); err != nil {
 		err = errors.Wrap(err, "worker: on done failed")
 		return
 	}
@@ -1,8 +1,7 @@
 package worker
 
 import (
-	"encoding/json"
-	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/asticode/go-astibob"
@@ -12,62 +11,144 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Serve spawns the server
 func (w *Worker) Serve() {
+	w.w.Serve(w.o.Server.Addr, w.Handler())
+}
+
+// Handler returns the handler of the server
+func (w *Worker) Handler() http.Handler {
 	// Create router
 	r := httprouter.New()
 
 	// Add routes
+	r.GET("/api/health", w.health)
 	r.GET("/api/ok", w.ok)
-	r.POST("/api/messages", w.handleWorkerMessage)
-
-	// Loop through runnables
-	w.mr.Lock()
-	for _, rn := range w.rs {
-		// Not operatable
-		o, ok := rn.(astibob.Operatable)
-		if !ok {
-			continue
-		}
+	r.GET("/api/messages/pending", w.pendingMessages)
+	r.GET("/api/metrics", astibob.MetricsHandle(w.m))
+	r.GET("/api/traces", w.traces)
+	r.GET("/api/traces/:id", w.trace)
+	astibob.AddDeadLetterRoutes(r, w.d)
+	r.GET("/api/outbox", w.outbox)
 
-		// Add routes
-		for p, rs := range o.Routes() {
+	// Add transport routes
+	var hasMessages bool
+	if t, ok := w.tr.(astibob.RoutedTransport); ok {
+		for p, rs := range t.Routes() {
 			for m, h := range rs {
-				r.Handle(m, fmt.Sprintf("/runnables/%s/routes%s", rn.Metadata().Name, p), h)
+				r.Handle(m, p, h)
+				if p == "/api/messages" && m == http.MethodPost {
+					hasMessages = true
+				}
 			}
 		}
+	}
+
+	// Messages can still be sent through HTTP when the transport doesn't do it
+	if !hasMessages {
+		r.POST("/api/messages", w.handleHTTPMessage)
+	}
+
+	// Runnables routers are looked up for each request so that runnables can be registered at any time
+	for _, m := range []string{http.MethodDelete, http.MethodGet, http.MethodPatch, http.MethodPost} {
+		r.Handle(m, "/runnables/:runnable/*path", w.runnableRouter)
+	}
 
-		// Add templates
-		for n, c := range o.Templates() {
-			r.GET(fmt.Sprintf("/runnables/%s/templates%s", rn.Metadata().Name, n), w.template(c))
+	// Chain middlewares
+	return astihttp.ChainMiddlewaresWithPrefix(r, []string{"/api/"}, astihttp.MiddlewareContentType("application/json"))
+}
+
+// Routes and templates of an operatable runnable are served by its own router, built when it's registered
+func newRunnableRouter(o astibob.Operatable) (r *httprouter.Router) {
+	// Create router
+	r = httprouter.New()
+
+	// Add routes
+	for p, rs := range o.Routes() {
+		for m, h := range rs {
+			r.Handle(m, "/routes"+p, h)
 		}
 	}
+
+	// Add templates
+	for n, c := range o.Templates() {
+		r.GET("/templates"+n, template(c))
+	}
+	return
+}
+
+func (w *Worker) runnableRouter(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Get router
+	w.mr.Lock()
+	rr, ok := w.rr[p.ByName("runnable")]
 	w.mr.Unlock()
 
-	// Chain middlewares
-	h := astihttp.ChainMiddlewaresWithPrefix(r, []string{"/api/"}, astihttp.MiddlewareContentType("application/json"))
+	// No router
+	if !ok {
+		rw.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Update path
+	u := *r.URL
+	u.Path = p.ByName("path")
+	u.RawPath = ""
+	r.URL = &u
 
 	// Serve
-	w.w.Serve(w.o.Server.Addr, h)
+	rr.ServeHTTP(rw, r)
 }
 
 func (w *Worker) ok(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {}
 
-func (w *Worker) handleWorkerMessage(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+func (w *Worker) handleHTTPMessage(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	// Read body
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "worker: reading body failed"))
+		return
+	}
+
+	// Handle
+	if err = w.handleWorkerMessage(r.Header.Get("Content-Type"), b); err != nil {
+		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "worker: handling worker message failed"))
+		return
+	}
+}
+
+func (w *Worker) handleWorkerMessage(contentType string, p []byte) (err error) {
 	// Unmarshal
-	var m astibob.Message
-	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
-		astibob.WriteHTTPError(rw, http.StatusInternalServerError, errors.Wrap(err, "worker: unmarshaling failed"))
+	var m *astibob.Message
+	if m, err = astibob.UnmarshalMessage(contentType, p); err != nil {
+		err = errors.Wrap(err, "worker: unmarshaling failed")
+		return
+	}
+
+	// Message has expired
+	if m.Expired() {
+		astilog.Debugf("worker: dropping expired message %s from worker %s", m.Name, m.From.WorkerName())
+		w.m.MessageExpired(m.From.WorkerName())
 		return
 	}
 
 	// Log
 	astilog.Debugf("worker: handling worker message %s", m.Name)
 
+	// Update metrics
+	w.m.MessageReceived(m.From.WorkerName())
+
+	// Message is delivered at least once
+	if m.AtLeastOnce() && m.Delivery.Key != "" {
+		w.handleMessageAtLeastOnce(m)
+		return
+	}
+
 	// Dispatch
-	w.d.Dispatch(&m)
+	w.d.Dispatch(m)
+	return
 }
 
-func (w *Worker) template(c []byte) httprouter.Handle {
+func template(c []byte) httprouter.Handle {
 	return func(rw http.ResponseWriter, req *http.Request, p httprouter.Params) {
 		// Write
 		if _, err := rw.Write(c); err != nil {
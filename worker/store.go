@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"net/url"
+	"path/filepath"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// StoreOptions are the options of the store shared by the runnables
+type StoreOptions struct {
+	Dir string `toml:"dir"` // If set, items are stored in this directory and survive restarts, otherwise they're kept in memory
+}
+
+func (w *Worker) addStore() {
+	// No dir
+	if w.o.Store.Dir == "" {
+		w.s = astibob.NewMemoryStore()
+		return
+	}
+
+	// Create store
+	var err error
+	p := filepath.Join(w.o.Store.Dir, url.PathEscape(w.name)+".db")
+	if w.s, err = astibob.NewFileStore(p); err != nil {
+		// Runnables can still use the store as long as the worker is running
+		astilog.Error(errors.Wrapf(err, "worker: creating store %s failed, falling back to memory", p))
+		w.s = astibob.NewMemoryStore()
+	}
+}
+
+func (w *Worker) closeStore() {
+	if err := w.s.Close(); err != nil {
+		astilog.Error(errors.Wrap(err, "worker: closing store failed"))
+	}
+}
+
+// Each runnable only sees its own items
+func (w *Worker) runnableStore(name string) astibob.Store {
+	return astibob.NewNamespacedStore(w.s, url.PathEscape(name))
+}
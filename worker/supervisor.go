@@ -0,0 +1,257 @@
+package worker
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	"github.com/asticode/go-astilog"
+	"github.com/pkg/errors"
+)
+
+// Restart policies
+const (
+	// The runnable is restarted whenever it stops on its own
+	RestartPolicyAlways RestartPolicy = "always"
+	// The runnable is never restarted. This is the default policy.
+	RestartPolicyNever RestartPolicy = "never"
+	// The runnable is restarted only when it stops with an error
+	RestartPolicyOnFailure RestartPolicy = "on_failure"
+)
+
+// Default restart options
+const (
+	defaultRestartJitter     = 0.2
+	defaultRestartMaxBackoff = time.Minute
+	defaultRestartMinBackoff = time.Second
+	defaultRestartWindow     = 10 * time.Minute
+)
+
+// RestartPolicy defines when a runnable that has stopped on its own is restarted. A runnable stopped through the
+// worker is never restarted.
+type RestartPolicy string
+
+// RestartOptions are the options of a runnable's supervisor
+type RestartOptions struct {
+	Jitter     float64       `toml:"jitter"`      // Fraction of the backoff randomly added or removed, defaults to 0.2
+	MaxBackoff time.Duration `toml:"max_backoff"` // Defaults to 1m
+	MaxRetries int           `toml:"max_retries"` // Number of restarts within the window after which the worker gives up, 0 means no limit
+	MinBackoff time.Duration `toml:"min_backoff"` // Backoff before the first restart, doubled after each restart within the window, defaults to 1s
+	Policy     RestartPolicy `toml:"policy"`
+	Window     time.Duration `toml:"window"` // Defaults to 10m
+}
+
+type supervisor struct {
+	as      []time.Time // Restarts within the window
+	o       RestartOptions
+	stopped bool // Whether the runnable has been stopped through the worker
+	t       *time.Timer
+}
+
+func newSupervisor(o RestartOptions) (s *supervisor) {
+	// Create supervisor
+	s = &supervisor{o: o}
+
+	// Default options
+	if s.o.Jitter <= 0 {
+		s.o.Jitter = defaultRestartJitter
+	}
+	if s.o.MaxBackoff <= 0 {
+		s.o.MaxBackoff = defaultRestartMaxBackoff
+	}
+	if s.o.MinBackoff <= 0 {
+		s.o.MinBackoff = defaultRestartMinBackoff
+	}
+	if s.o.Policy == "" {
+		s.o.Policy = RestartPolicyNever
+	}
+	if s.o.Window <= 0 {
+		s.o.Window = defaultRestartWindow
+	}
+	return
+}
+
+func (s *supervisor) shouldRestart(err error) bool {
+	switch s.o.Policy {
+	case RestartPolicyAlways:
+		return true
+	case RestartPolicyOnFailure:
+		return err != nil
+	default:
+		return false
+	}
+}
+
+func (s *supervisor) backoff() (d time.Duration) {
+	// Exponential backoff
+	d = s.o.MinBackoff
+	for idx := 1; idx < len(s.as) && d < s.o.MaxBackoff; idx++ {
+		d *= 2
+	}
+	if d > s.o.MaxBackoff {
+		d = s.o.MaxBackoff
+	}
+
+	// Jitter
+	d += time.Duration((rand.Float64()*2 - 1) * s.o.Jitter * float64(d))
+	return
+}
+
+// Returns whether a restart was pending
+func (s *supervisor) cancel() (ok bool) {
+	if s.t != nil {
+		s.t.Stop()
+		s.t = nil
+		ok = true
+	}
+	return
+}
+
+// Supervisors are created when runnables are registered and removed when they're unregistered. Assumes the
+// runnables mutex is held.
+func (w *Worker) supervisor(name string) (s *supervisor, ok bool) {
+	s, ok = w.sv[name]
+	return
+}
+
+// Executed when the runnable is started through the worker
+func (w *Worker) superviseRunnable(name string) {
+	// Lock
+	w.mr.Lock()
+	defer w.mr.Unlock()
+
+	// Get supervisor
+	s, ok := w.supervisor(name)
+	if !ok {
+		return
+	}
+
+	// Update supervisor
+	s.stopped = false
+	s.cancel()
+}
+
+// Executed when the runnable is stopped through the worker. Returns whether a pending restart has been cancelled.
+func (w *Worker) unsuperviseRunnable(name string) bool {
+	// Lock
+	w.mr.Lock()
+	defer w.mr.Unlock()
+
+	// Get supervisor
+	s, ok := w.supervisor(name)
+	if !ok {
+		return false
+	}
+
+	// Update supervisor
+	s.stopped = true
+	return s.cancel()
+}
+
+// Executed once the runnable has stopped
+func (w *Worker) onRunnableExit(name string, err error) {
+	// Worker is stopping
+	if w.w.Context().Err() != nil {
+		return
+	}
+
+	// Lock
+	w.mr.Lock()
+
+	// Runnable has been unregistered or should not be restarted
+	s, ok := w.supervisor(name)
+	if !ok || s.stopped || !s.shouldRestart(err) {
+		w.mr.Unlock()
+		return
+	}
+
+	// Only keep restarts within the window
+	n := time.Now()
+	var as []time.Time
+	for _, a := range s.as {
+		if n.Sub(a) < s.o.Window {
+			as = append(as, a)
+		}
+	}
+	s.as = as
+
+	// Create restart
+	r := astibob.RunnableRestart{
+		Attempt:    len(s.as) + 1,
+		MaxRetries: s.o.MaxRetries,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	// Too many restarts
+	if s.o.MaxRetries > 0 && len(s.as) >= s.o.MaxRetries {
+		// Unlock
+		w.mr.Unlock()
+
+		// Log
+		astilog.Errorf("worker: giving up restarting runnable %s after %d restart(s) within %s", name, len(as), s.o.Window)
+
+		// Dispatch
+		r.Attempt = len(as)
+		m, err := astibob.NewRunnableGaveUpMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
+			astibob.UIIdentifierType:     true,
+			astibob.WorkerIdentifierType: true,
+		}}, r)
+		if err != nil {
+			astilog.Error(errors.Wrap(err, "worker: creating gave up message failed"))
+			return
+		}
+		w.d.Dispatch(m)
+		return
+	}
+
+	// Schedule restart
+	s.as = append(s.as, n)
+	d := s.backoff()
+	s.t = time.AfterFunc(d, func() { w.restartRunnable(name) })
+	r.Delay = d.Seconds()
+
+	// Unlock
+	w.mr.Unlock()
+
+	// Log
+	astilog.Infof("worker: restarting runnable %s in %s (attempt %d)", name, d, r.Attempt)
+
+	// Dispatch
+	m, err := astibob.NewRunnableRestartingMessage(*w.runnableIdentifier(name), &astibob.Identifier{Types: map[string]bool{
+		astibob.UIIdentifierType:     true,
+		astibob.WorkerIdentifierType: true,
+	}}, r)
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "worker: creating restarting message failed"))
+		return
+	}
+	w.d.Dispatch(m)
+}
+
+func (w *Worker) restartRunnable(name string) {
+	// Worker is stopping
+	if w.w.Context().Err() != nil {
+		return
+	}
+
+	// Lock
+	w.mr.Lock()
+
+	// Runnable has been unregistered, stopped or started through the worker in the meantime
+	s, ok := w.supervisor(name)
+	if !ok || s.stopped || s.t == nil {
+		w.mr.Unlock()
+		return
+	}
+	s.t = nil
+
+	// Unlock
+	w.mr.Unlock()
+
+	// Start
+	if err := w.startRunnable(name); err != nil {
+		astilog.Error(errors.Wrapf(err, "worker: restarting runnable %s failed", name))
+	}
+}
@@ -0,0 +1,191 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asticode/go-astibob"
+	astiptr "github.com/asticode/go-astitools/ptr"
+)
+
+func TestSupervisorBackoff(t *testing.T) {
+	s := newSupervisor(RestartOptions{
+		MaxBackoff: 4 * time.Second,
+		MinBackoff: time.Second,
+	})
+	for _, c := range []struct {
+		e time.Duration
+		n int
+	}{
+		{e: time.Second, n: 1},
+		{e: 2 * time.Second, n: 2},
+		{e: 4 * time.Second, n: 3},
+		{e: 4 * time.Second, n: 10},
+	} {
+		// Restarts within the window
+		s.as = make([]time.Time, c.n)
+
+		// Jitter is applied
+		min, max := time.Duration(float64(c.e)*(1-s.o.Jitter)), time.Duration(float64(c.e)*(1+s.o.Jitter))
+		if d := s.backoff(); d < min || d > max {
+			t.Errorf("expected backoff after %d restart(s) between %s and %s, got %s", c.n, min, max, d)
+		}
+	}
+}
+
+// testSupervisedRunnable counts its starts and stops on its own
+type testSupervisedRunnable struct {
+	*astibob.BaseRunnable
+	m *sync.Mutex // Locks n
+	n int
+}
+
+func newTestSupervisedRunnable(err error) (r *testSupervisedRunnable) {
+	r = &testSupervisedRunnable{m: &sync.Mutex{}}
+	r.BaseRunnable = astibob.NewBaseRunnable(astibob.BaseRunnableOptions{
+		Metadata: astibob.Metadata{Name: "runnable"},
+		OnStart: func(ctx context.Context) error {
+			r.m.Lock()
+			r.n++
+			r.m.Unlock()
+			return err
+		},
+	})
+	return
+}
+
+func (r *testSupervisedRunnable) starts() int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.n
+}
+
+func TestSupervisorRestarts(t *testing.T) {
+	for _, c := range []struct {
+		err    error
+		gaveUp bool
+		n      string
+		o      RestartOptions
+		starts int
+	}{
+		{
+			err:    errors.New("test"),
+			n:      "never",
+			starts: 1,
+		},
+		{
+			err:    errors.New("test"),
+			gaveUp: true,
+			n:      "on failure with error",
+			o:      RestartOptions{MaxRetries: 2, Policy: RestartPolicyOnFailure},
+			starts: 3,
+		},
+		{
+			n:      "on failure without error",
+			o:      RestartOptions{MaxRetries: 2, Policy: RestartPolicyOnFailure},
+			starts: 1,
+		},
+		{
+			gaveUp: true,
+			n:      "always without error",
+			o:      RestartOptions{MaxRetries: 2, Policy: RestartPolicyAlways},
+			starts: 3,
+		},
+		{
+			err:    errors.New("test"),
+			n:      "restarts outside the window",
+			o:      RestartOptions{MaxRetries: 1, Policy: RestartPolicyOnFailure, Window: time.Nanosecond},
+			starts: 5,
+		},
+	} {
+		t.Run(c.n, func(t *testing.T) {
+			// Create worker
+			w := New("worker", Options{})
+			defer w.Close()
+			defer w.Stop()
+
+			// Listen to gave up messages
+			gaveUp := make(chan bool, 1)
+			w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableGaveUpMessage)}, func(m *astibob.Message) error {
+				gaveUp <- true
+				return nil
+			})
+
+			// Register runnable
+			c.o.MaxBackoff = time.Millisecond
+			c.o.MinBackoff = time.Millisecond
+			r := newTestSupervisedRunnable(c.err)
+			w.RegisterRunnables(Runnable{
+				Restart:  c.o,
+				Runnable: r,
+			})
+
+			// Start
+			if err := w.startRunnable("runnable"); err != nil {
+				t.Fatalf("starting runnable failed: %v", err)
+			}
+
+			// Wait for the expected number of starts
+			for d := time.Now().Add(time.Second); r.starts() < c.starts; {
+				if time.Now().After(d) {
+					t.Fatalf("expected %d start(s), got %d", c.starts, r.starts())
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			// Runnable is not restarted anymore unless restarts are forgotten
+			if c.o.Window == time.Nanosecond {
+				w.UnregisterRunnable("runnable")
+			} else {
+				time.Sleep(50 * time.Millisecond)
+				if n := r.starts(); n != c.starts {
+					t.Errorf("expected %d start(s), got %d", c.starts, n)
+				}
+			}
+
+			// Gave up
+			select {
+			case <-gaveUp:
+				if !c.gaveUp {
+					t.Error("expected worker not to give up")
+				}
+			case <-time.After(50 * time.Millisecond):
+				if c.gaveUp {
+					t.Error("expected worker to give up")
+				}
+			}
+		})
+	}
+}
+
+func TestSupervisorLifecycle(t *testing.T) {
+	// Create worker
+	w := New("worker", Options{})
+	defer w.Close()
+	defer w.Stop()
+
+	// Unknown runnables are not supervised
+	w.superviseRunnable("unknown")
+	w.onRunnableExit("unknown", errors.New("test"))
+	if n := len(w.sv); n != 0 {
+		t.Errorf("expected no supervisor, got %d", n)
+	}
+
+	// Supervisor is created when the runnable is registered
+	w.RegisterRunnables(Runnable{Runnable: newTestSupervisedRunnable(nil)})
+	if _, ok := w.sv["runnable"]; !ok {
+		t.Error("expected supervisor to be created")
+	}
+
+	// Supervisor is removed when the runnable is unregistered
+	if err := w.UnregisterRunnable("runnable"); err != nil {
+		t.Fatalf("unregistering runnable failed: %v", err)
+	}
+	w.unsuperviseRunnable("runnable")
+	if n := len(w.sv); n != 0 {
+		t.Errorf("expected no supervisor, got %d", n)
+	}
+}
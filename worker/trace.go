@@ -0,0 +1,31 @@
+package worker
+
+import (
+	"net/http"
+
+	"github.com/asticode/go-astibob"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Tags of the spans recorded by the worker
+const (
+	listenableHandlerTag = "listenable"
+	onHandlerTag         = "on"
+	runnableHandlerTag   = "runnable"
+)
+
+func handlerTags(handler, runnable string) (ts map[string]string) {
+	ts = map[string]string{"handler": handler}
+	if runnable != "" {
+		ts["runnable"] = runnable
+	}
+	return
+}
+
+func (w *Worker) traces(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, w.t.Traces())
+}
+
+func (w *Worker) trace(rw http.ResponseWriter, r *http.Request, p httprouter.Params) {
+	astibob.WriteHTTPData(rw, w.t.Spans(p.ByName("id")))
+}
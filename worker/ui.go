@@ -6,6 +6,17 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Messages sent to the index even though no UI requested them since it keeps track of runnables statuses
+var indexMessageNames = map[string]bool{
+	astibob.RunnableConfigUpdatedMessage: true,
+	astibob.RunnableCrashedMessage:       true,
+	astibob.RunnableHealthMessage:        true,
+	astibob.RunnableStartedMessage:       true,
+	astibob.RunnableStartingMessage:      true,
+	astibob.RunnableStoppedMessage:       true,
+	astibob.RunnableStoppingMessage:      true,
+}
+
 func (w *Worker) addUIMessageNames(m *astibob.Message) (err error) {
 	// Parse payload
 	var names []string
@@ -48,7 +59,7 @@ func (w *Worker) sendMessageToUI(m *astibob.Message) (err error) {
 
 	// No UI requested this message
 	w.mu.Lock()
-	if _, ok := w.us[m.Name]; !ok {
+	if _, ok := w.us[m.Name]; !ok && !indexMessageNames[m.Name] {
 		w.mu.Unlock()
 		return
 	}
@@ -58,8 +69,8 @@ func (w *Worker) sendMessageToUI(m *astibob.Message) (err error) {
 	astilog.Debugf("worker: sending %s message to ui", m.Name)
 
 	// Write
-	if err = w.cw.WriteJSON(m); err != nil {
-		err = errors.Wrap(err, "worker: writing JSON message failed")
+	if err = w.writeToIndex(m); err != nil {
+		err = errors.Wrap(err, "worker: writing message to index failed")
 		return
 	}
 	return
@@ -1,45 +1,70 @@
 package worker
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/asticode/go-astibob"
 	"github.com/asticode/go-astilog"
 	astiptr "github.com/asticode/go-astitools/ptr"
 	astiworker "github.com/asticode/go-astitools/worker"
-	"github.com/asticode/go-astiws"
+	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 )
 
 type Options struct {
-	Index  astibob.ServerOptions `toml:"index"`
-	Server astibob.ServerOptions `toml:"server"`
+	Config     ConfigOptions             `toml:"config"`
+	Dispatcher astibob.DispatcherOptions `toml:"dispatcher"`
+	Health     HealthOptions             `toml:"health"`
+	Index      astibob.ServerOptions     `toml:"index"`
+	Outbox     astibob.OutboxOptions     `toml:"outbox"`
+	Recorder   astibob.RecorderOptions   `toml:"recorder"`
+	Server     astibob.ServerOptions     `toml:"server"`
+	Store      StoreOptions              `toml:"store"`
+	Tracer     astibob.TracerOptions     `toml:"tracer"`
+	Transport  astibob.Transport         `toml:"-"` // Defaults to the HTTP transport
 }
 
 type Worker struct {
-	ch   *http.Client
-	cw   *astiws.Client
+	ci   astibob.Codec // Index codec
 	d    *astibob.Dispatcher
-	ds   map[int]OnDone // On done callbacks indexed by message id
+	di   int // Index dials count
+	dd   *astibob.Deduplicator
+	ds   map[int]*pendingMessage   // Pending messages indexed by id
+	hs   map[string]astibob.Health // Running runnables healths indexed by name
+	ic   astibob.TransportConn     // Index connection
 	id   int
-	ls   map[string]map[string]map[string]bool // Worker's listenables indexed by worker --> runnable --> message
-	md   *sync.Mutex                           // Locks ds
-	mi   *sync.Mutex                           // Locks id
-	ml   *sync.Mutex                           // Locks ls
-	mo   *sync.Mutex                           // Locks ols
-	mr   *sync.Mutex                           // Locks rs
-	mu   *sync.Mutex                           // Locks us
-	mw   *sync.Mutex                           // Locks ws
+	ls   map[string]map[string]map[string]map[string]int // Worker's listenables count indexed by worker --> runnable --> message --> filter expression, empty when not filtered
+	m    *astibob.Metrics
+	mc   *sync.Mutex // Locks ci, di and ic
+	md   *sync.Mutex // Locks ds
+	mf   *sync.Mutex // Locks runnables config updates
+	mi   *sync.Mutex // Locks id
+	ob   *astibob.Outbox
+	ml   *sync.Mutex // Locks ls
+	mo   *sync.Mutex // Locks ols
+	mr   *sync.Mutex // Locks hs, re, rh, rr, rs, st, sv and ts
+	mu   *sync.Mutex // Locks us
+	mw   *sync.Mutex // Locks ws
 	name string
 	o    Options
-	ols  map[string]map[string]map[string]bool // Other workers listenables indexed by runnable --> worker --> message
+	ols  map[string]map[string]map[string][]*astibob.Filter // Other workers listenables filters indexed by runnable --> worker --> message, nil when not filtered
+	rc   *astibob.Recorder
+	re   map[string]*runnableExecution    // Runnables executions indexed by name
+	rh   map[string]*astibob.Subscription // Runnables dispatcher handlers indexed by name
+	rr   map[string]*httprouter.Router    // Runnables routers indexed by name
 	rs   map[string]astibob.Runnable
-	us   map[string]bool // UI messages names indexed by message
+	s    astibob.Store
+	st   map[string]time.Time   // Runnables start times indexed by name
+	sv   map[string]*supervisor // Runnables supervisors indexed by name
+	t    *astibob.Tracer
+	tr   astibob.Transport
+	ts   map[string]astibob.RunnableTransition // Runnables last transitions indexed by name
+	us   map[string]bool                       // UI messages names indexed by message
 	w    *astiworker.Worker
 	ws   map[string]*worker
 }
@@ -48,11 +73,15 @@ type Worker struct {
 func New(name string, o Options) (w *Worker) {
 	// Create worker
 	w = &Worker{
-		ch:   &http.Client{},
-		cw:   astiws.NewClient(astiws.ClientConfiguration{}),
-		ds:   make(map[int]OnDone),
-		ls:   make(map[string]map[string]map[string]bool),
+		ci:   astibob.DefaultCodec(),
+		dd:   astibob.NewDeduplicator(0),
+		ds:   make(map[int]*pendingMessage),
+		hs:   make(map[string]astibob.Health),
+		ls:   make(map[string]map[string]map[string]map[string]int),
+		m:    astibob.NewMetrics(),
+		mc:   &sync.Mutex{},
 		md:   &sync.Mutex{},
+		mf:   &sync.Mutex{},
 		mi:   &sync.Mutex{},
 		ml:   &sync.Mutex{},
 		mo:   &sync.Mutex{},
@@ -61,8 +90,16 @@ func New(name string, o Options) (w *Worker) {
 		mw:   &sync.Mutex{},
 		name: name,
 		o:    o,
-		ols:  make(map[string]map[string]map[string]bool),
+		ols:  make(map[string]map[string]map[string][]*astibob.Filter),
+		re:   make(map[string]*runnableExecution),
+		rh:   make(map[string]*astibob.Subscription),
+		rr:   make(map[string]*httprouter.Router),
 		rs:   make(map[string]astibob.Runnable),
+		st:   make(map[string]time.Time),
+		sv:   make(map[string]*supervisor),
+		t:    astibob.NewTracer(name, o.Tracer),
+		tr:   o.Transport,
+		ts:   make(map[string]astibob.RunnableTransition),
 		us:   make(map[string]bool),
 		w:    astiworker.NewWorker(),
 		ws:   make(map[string]*worker),
@@ -70,12 +107,45 @@ func New(name string, o Options) (w *Worker) {
 
 	// Create dispatcher
 	w.d = astibob.NewDispatcher(w.w.Context(), w.w.NewTask)
+	w.d.SetOptions(o.Dispatcher)
+	w.d.OnOverflow(w.sendDispatcherOverflow)
 
-	// Add websocket message handler
-	w.cw.SetMessageHandler(w.handleIndexMessage)
+	// Add metrics
+	w.addMetrics()
+
+	// Add recorder
+	w.addRecorder()
+
+	// Add outbox
+	w.addOutbox()
+
+	// Add health
+	w.addHealth()
+
+	// Add store
+	w.addStore()
+
+	// Start tracer
+	t := w.w.NewTask()
+	go func() {
+		defer t.Done()
+		w.t.Start(w.w.Context())
+	}()
+
+	// Default transport
+	if w.tr == nil {
+		w.tr = astibob.NewHTTPTransport(astibob.HTTPTransportOptions{Index: o.Index})
+	}
+
+	// Listen to other workers
+	if err := w.tr.ListenWorker(w.w.Context(), name, w.handleWorkerMessage); err != nil {
+		astilog.Error(errors.Wrap(err, "worker: listening to other workers failed"))
+	}
 
 	// Add dispatcher handlers
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.ListenablesRegisterMessage)}, w.registerListenables)
+	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.ListenablesUnregisterMessage)}, w.unregisterListenables)
+	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.MessageAckMessage)}, w.ackMessage)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableDoneMessage)}, w.doneMessage)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableStartMessage)}, w.startRunnableFromMessage)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.RunnableStopMessage)}, w.stopRunnableFromMessage)
@@ -83,6 +153,7 @@ func New(name string, o Options) (w *Worker) {
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.UIMessageNamesDeleteMessage)}, w.deleteUIMessageNames)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerRegisteredMessage)}, w.registerWorker)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerDisconnectedMessage)}, w.unregisterWorker)
+	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerUpdatedMessage)}, w.updateWorker)
 	w.d.On(astibob.DispatchConditions{Name: astiptr.Str(astibob.WorkerWelcomeMessage)}, w.finishRegistration)
 	w.d.On(astibob.DispatchConditions{To: &astibob.Identifier{Type: astibob.IndexIdentifierType}}, w.sendMessageToIndex)
 	w.d.On(astibob.DispatchConditions{To: &astibob.Identifier{Type: astibob.UIIdentifierType}}, w.sendMessageToUI)
@@ -98,14 +169,63 @@ func (w *Worker) HandleSignals() {
 	w.w.HandleSignals()
 }
 
+// Stop stops the worker
+func (w *Worker) Stop() {
+	w.w.Stop()
+}
+
 // Wait waits for the index to be stopped
 func (w *Worker) Wait() {
 	w.w.Wait()
 }
 
-// On makes sure to handle messages with specific conditions
-func (w *Worker) On(c astibob.DispatchConditions, h astibob.MessageHandler) {
-	w.d.On(c, h)
+// On makes sure to handle messages with specific conditions until the returned subscription is turned off
+func (w *Worker) On(c astibob.DispatchConditions, h astibob.MessageHandler) *astibob.Subscription {
+	return w.d.OnNamed(astibob.HandlerName(h), c, w.t.Handler(handlerTags(onHandlerTag, ""), h))
+}
+
+// Use adds a middleware wrapping all handlers, including built-in ones, until the returned subscription is turned off
+func (w *Worker) Use(m astibob.Middleware) *astibob.Subscription {
+	return w.d.Use(m)
+}
+
+// UseOn adds a middleware wrapping all handlers of messages matching the conditions, including built-in ones, until the
+// returned subscription is turned off
+func (w *Worker) UseOn(c astibob.DispatchConditions, m astibob.Middleware) *astibob.Subscription {
+	return w.d.UseOn(c, m)
+}
+
+// Scope creates a scope whose handlers are all removed together, at the latest when the context is done
+func (w *Worker) Scope(ctx context.Context) *astibob.Scope {
+	return w.d.Scope(ctx)
+}
+
+// SetKeyQueueOptions sets the options of the dispatcher queue with a specific key such as
+// "from.runnable.<worker>.<runnable>"
+func (w *Worker) SetKeyQueueOptions(key string, o astibob.QueueOptions) {
+	w.d.SetKeyQueueOptions(key, o)
+}
+
+// SetMessageQueueOptions sets the dispatcher queue options of messages with a specific name
+func (w *Worker) SetMessageQueueOptions(name string, o astibob.QueueOptions) {
+	w.d.SetMessageQueueOptions(name, o)
+}
+
+// DispatcherStats returns the stats of the dispatcher queues
+func (w *Worker) DispatcherStats() []astibob.DispatcherQueueStats {
+	return w.d.Stats()
+}
+
+func (w *Worker) sendDispatcherOverflow(o astibob.DispatcherOverflow) {
+	// Create message
+	m, err := astibob.NewDispatcherOverflowMessage(*w.workerIdentifier(), &astibob.Identifier{Type: astibob.UIIdentifierType}, o)
+	if err != nil {
+		astilog.Error(errors.Wrap(err, "worker: creating dispatcher overflow message failed"))
+		return
+	}
+
+	// Dispatch
+	w.d.Dispatch(m)
 }
 
 // Close closes the worker properly
@@ -113,10 +233,16 @@ func (w *Worker) Close() error {
 	// Close dispatcher
 	w.d.Close()
 
-	// Close client
-	if w.cw != nil {
-		if err := w.cw.Close(); err != nil {
-			astilog.Error(errors.Wrap(err, "worker: closing client failed"))
+	// Close recorder
+	w.closeRecorder()
+
+	// Close store
+	w.closeStore()
+
+	// Close index connection
+	if c := w.indexConn(); c != nil {
+		if err := c.Close(); err != nil {
+			astilog.Error(errors.Wrap(err, "worker: closing index connection failed"))
 		}
 	}
 	return nil
@@ -132,6 +258,8 @@ func (w *Worker) runnableIdentifier(name string) *astibob.Identifier {
 
 type worker struct {
 	addr string
+	c    astibob.Codec
+	cs   []string
 	mr   *sync.Mutex // Locks rs
 	name string
 	rs   map[string]astibob.RunnableMessage
@@ -141,6 +269,8 @@ func newWorker(i astibob.Worker) (w *worker) {
 	// Create
 	w = &worker{
 		addr: i.Addr,
+		c:    astibob.NegotiateCodec(i.Codecs),
+		cs:   i.Codecs,
 		mr:   &sync.Mutex{},
 		name: i.Name,
 		rs:   make(map[string]astibob.RunnableMessage),
@@ -160,8 +290,9 @@ func (w *worker) toMessage() (o astibob.Worker) {
 
 	// Create worker
 	o = astibob.Worker{
-		Addr: w.addr,
-		Name: w.name,
+		Addr:   w.addr,
+		Codecs: w.cs,
+		Name:   w.name,
 	}
 
 	// Loop through runnables
@@ -187,6 +318,16 @@ func (w *Worker) sendMessageToWorker(m *astibob.Message) (err error) {
 		return
 	}
 
+	// Messages delivered at least once need a key
+	if m.AtLeastOnce() && m.Delivery.Key == "" {
+		c := *m
+		c.Delivery = &astibob.Delivery{
+			Key:  w.deliveryKey(),
+			Mode: m.Delivery.Mode,
+		}
+		m = &c
+	}
+
 	// Get workers
 	var ws []*worker
 	if tw := m.To.WorkerName(); tw != "" {
@@ -202,6 +343,14 @@ func (w *Worker) sendMessageToWorker(m *astibob.Message) (err error) {
 
 		// No worker
 		if !ok {
+			// The message will be sent once the worker has registered
+			if m.AtLeastOnce() {
+				if err = w.sendMessageAtLeastOnce(m, tw); err != nil {
+					err = errors.Wrapf(err, "worker: sending message at least once to worker %s failed", tw)
+					return
+				}
+				return
+			}
 			err = fmt.Errorf("worker: worker %s doesn't exist", tw)
 			return
 		}
@@ -227,57 +376,96 @@ func (w *Worker) sendMessageToWorker(m *astibob.Message) (err error) {
 		w.mw.Unlock()
 	}
 
-	// Loop through workers
+	// Send to workers concurrently so that a slow worker doesn't delay the others
+	de := make(DeliveryError)
+	mde := &sync.Mutex{} // Locks de
+	wg := &sync.WaitGroup{}
 	for _, mw := range ws {
-		// Log
-		astilog.Debugf("worker: sending message %s to worker %s", m.Name, mw.name)
-
-		// Marshal
-		var b []byte
-		if b, err = json.Marshal(m); err != nil {
-			err = errors.Wrap(err, "worker: marshaling failed")
-			return
-		}
+		wg.Add(1)
+		go func(mw *worker) {
+			// Send
+			defer wg.Done()
+			if err := w.sendMessageToOneWorker(m, mw); err != nil {
+				mde.Lock()
+				de[mw.name] = err
+				mde.Unlock()
+			}
+		}(mw)
+	}
+	wg.Wait()
 
-		// Send request
-		if err = w.sendRequestToWorker(http.MethodPost, fmt.Sprintf("%s/api/messages", mw.addr), bytes.NewReader(b)); err != nil {
-			err = errors.Wrapf(err, "worker: sending request to worker %s failed", mw.name)
-			return
-		}
+	// Delivery failed
+	if len(de) > 0 {
+		err = de
+		return
 	}
 	return
 }
 
-func (w *Worker) sendRequestToWorker(method, url string, body io.Reader) (err error) {
-	// Create request
-	var req *http.Request
-	if req, err = http.NewRequest(method, url, body); err != nil {
-		err = errors.Wrap(err, "worker: creating request failed")
-		return
+func (w *Worker) sendMessageToOneWorker(m *astibob.Message, mw *worker) (err error) {
+	// Message must be delivered at least once
+	if m.AtLeastOnce() {
+		return w.sendMessageAtLeastOnce(m, mw.name)
 	}
+	return w.sendToWorker(m, mw)
+}
 
-	// Send request
-	var resp *http.Response
-	if resp, err = w.ch.Do(req); err != nil {
-		err = errors.Wrap(err, "worker: sending request failed")
+func (w *Worker) sendToWorker(m *astibob.Message, mw *worker) (err error) {
+	// Message has expired
+	if m.Expired() {
+		astilog.Debugf("worker: dropping expired message %s to worker %s", m.Name, mw.name)
+		w.m.MessageExpired(mw.name)
 		return
 	}
-	defer resp.Body.Close()
-
-	// Check status code
-	if resp.StatusCode != http.StatusOK {
-		// Unmarshal
-		// We silence the error since there may not be an error message in the response
-		var e astibob.Error
-		json.NewDecoder(resp.Body).Decode(&e)
-
-		// Log
-		if e.Message != "" {
-			err = fmt.Errorf("worker: response error message is %s", e.Message)
-		} else {
-			err = fmt.Errorf("worker: response status code is %d", resp.StatusCode)
+
+	// Log
+	astilog.Debugf("worker: sending message %s to worker %s with codec %s", m.Name, mw.name, mw.c.Name())
+
+	// Workers that don't advertise codecs only understand JSON
+	sm := m
+	if len(mw.cs) == 0 {
+		if sm, err = m.WithoutBinary(); err != nil {
+			err = errors.Wrap(err, "worker: removing binary payload failed")
+			return
 		}
+	}
+
+	// Marshal
+	var b []byte
+	if b, err = mw.c.Marshal(sm); err != nil {
+		err = errors.Wrap(err, "worker: marshaling failed")
 		return
 	}
+
+	// Send
+	if err = w.tr.SendToWorker(w.w.Context(), mw.name, mw.addr, mw.c.ContentType(), b); err != nil {
+		w.m.Counter(astibob.WorkerRequestFailuresMetric, "Number of failed requests to other workers", "worker").Add(1, mw.name)
+		err = errors.Wrap(err, "worker: sending failed")
+		return
+	}
+
+	// Update metrics
+	w.m.MessageSent(mw.name)
 	return
 }
+
+// DeliveryError is returned when a message couldn't be delivered to some workers. Errors are indexed by worker name.
+type DeliveryError map[string]error
+
+func (e DeliveryError) Error() string {
+	// Get keys
+	var ks []string
+	for k := range e {
+		ks = append(ks, k)
+	}
+
+	// Sort keys
+	sort.Strings(ks)
+
+	// Loop through keys
+	var ss []string
+	for _, k := range ks {
+		ss = append(ss, fmt.Sprintf("worker %s: %s", k, e[k]))
+	}
+	return "worker: delivering message failed: " + strings.Join(ss, ", ")
+}